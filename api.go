@@ -33,14 +33,26 @@ func (o TagIdentifierOption) set(options *govalidator.Options) {
 	options.TagIdentifier = string(o)
 }
 
+// RegisterAll registers every rule of `DefaultRules` in govalidator under its
+// canonical tag name. It must be called only once, govalidator panics when a
+// rule name is registered twice.
+func RegisterAll() {
+	for tag, rule := range DefaultRules {
+		govalidator.AddCustomRule(tag, rule)
+	}
+}
+
+// ValidateQueryParams validates the query parameters of r against rules.
 func ValidateQueryParams(r *http.Request, rules Rules, options ...Option) url.Values {
 	return newValidator(r, nil, rules, options).Validate()
 }
 
+// ValidateJSONBody decodes the JSON body of r into data and validates it.
 func ValidateJSONBody(r *http.Request, data interface{}, rules Rules, options ...Option) url.Values {
 	return newValidator(r, data, rules, options).ValidateJSON()
 }
 
+// ValidateStruct validates the fields of data against rules.
 func ValidateStruct(data interface{}, rules Rules, options ...Option) url.Values {
 	return newValidator(nil, data, rules, options).ValidateStruct()
 }
@@ -16,6 +16,8 @@ import (
 func init() {
 	govalidator.AddCustomRule("eos.blockNum", EOSBlockNumRule)
 	govalidator.AddCustomRule("eos.name", EOSNameRule)
+
+	RegisterAll()
 }
 
 func TestValidateQueryParams(t *testing.T) {
@@ -46,6 +48,37 @@ func TestValidateQueryParams(t *testing.T) {
 	}
 }
 
+func TestValidateQueryParams_RegisterAll(t *testing.T) {
+	rules := Rules{
+		"account":   []string{"eos_name"},
+		"accounts":  []string{"eos_names_list"},
+		"block_num": []string{"eos_block_num"},
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		errors url.Values
+	}{
+		{"all valid", "account=eosio&accounts=eosio|eosio.token&block_num=1", url.Values{}},
+		{"all not valid", "account=6&accounts=eosio|6&block_num=a", url.Values{
+			"account":   []string{"The account field must be a valid EOS name"},
+			"accounts":  []string{"The accounts[1] field must be a valid EOS name"},
+			"block_num": []string{"The block_num field must be a valid EOS block num"},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, err := http.NewRequest("GET", "/?"+test.query, nil)
+			require.NoError(t, err)
+
+			errors := ValidateQueryParams(request, rules)
+			assert.Equal(t, test.errors, errors)
+		})
+	}
+}
+
 func TestValidateStruct(t *testing.T) {
 	singleRules := map[string][]string{
 		"account": []string{"eos.name"},
@@ -0,0 +1,84 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+)
+
+// AllowEmpty wraps `inner` so that an empty string or nil value is accepted as
+// "not provided", any other value is validated by `inner`.
+func AllowEmpty(inner RuleFunc) RuleFunc {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present || value == "" {
+			return nil
+		}
+
+		return inner(field, rule, message, value)
+	}
+}
+
+// Not inverts `inner`: the value is accepted when `inner` rejects it and rejected,
+// with `failureMessage` (its `{field}` placeholder replaced by the field name), when
+// `inner` accepts it. Combined with `OneOfRuleFactory`, it expresses deny-lists.
+func Not(inner RuleFunc, failureMessage string) RuleFunc {
+	return func(field string, rule string, message string, value interface{}) error {
+		if inner(field, rule, message, value) != nil {
+			return nil
+		}
+
+		return newTemplateError("not", field, rule, failureMessage)
+	}
+}
+
+// And combines `rules`, the value is accepted only if every rule accepts it, the
+// first failure is returned.
+func And(rules ...RuleFunc) RuleFunc {
+	rules = append([]RuleFunc(nil), rules...)
+
+	return func(field string, rule string, message string, value interface{}) error {
+		for _, ruleFunc := range rules {
+			if err := ruleFunc(field, rule, message, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// Or combines `rules`, the value is accepted if any rule accepts it. When they all
+// fail, every rule's message is reported, joined with `; `, under the code of the
+// first rule's `ValidationError` so `errors.Is` still matches its sentinel. Without
+// any rule, every value is accepted.
+func Or(rules ...RuleFunc) RuleFunc {
+	rules = append([]RuleFunc(nil), rules...)
+
+	return func(field string, rule string, message string, value interface{}) error {
+		var messages []string
+		var first *ValidationError
+		for _, ruleFunc := range rules {
+			err := ruleFunc(field, rule, message, value)
+			if err == nil {
+				return nil
+			}
+
+			if first == nil {
+				errors.As(err, &first)
+			}
+
+			messages = append(messages, err.Error())
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		code := errorCode("or")
+		if first != nil {
+			code = first.Code
+		}
+
+		return &ValidationError{Field: field, Tag: rule, Code: code, Message: strings.Join(messages, "; ")}
+	}
+}
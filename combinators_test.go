@@ -0,0 +1,96 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowEmpty(t *testing.T) {
+	tag := "hex"
+	rule := AllowEmpty(HexRule)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	emptyString := ""
+	var nilString *string
+
+	tests := []ruleTestCase{
+		{"should validate non empty", "zz", "The test field must be a valid hexadecimal"},
+		{"should validate non empty type", true, "The test field must be a string"},
+		{"should validate non empty pointer", &[]string{"zz"}[0], "The test field must be a valid hexadecimal"},
+
+		{"valid nil", nil, ""},
+		{"valid nil pointer", nilString, ""},
+		{"valid empty", "", ""},
+		{"valid empty pointer", &emptyString, ""},
+		{"valid", "ab", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestNot(t *testing.T) {
+	tag := "account"
+	rule := Not(OneOfRuleFactory("eosio", "eosio.token"), "The {field} field must not be a system account")
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should not be denied", "eosio", "The test field must not be a system account"},
+		{"should not be any denied", "eosio.token", "The test field must not be a system account"},
+
+		{"valid", "bob", ""},
+		{"valid inner type error", true, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestAnd(t *testing.T) {
+	tag := "account"
+	rule := And(EOSNameRule, Not(OneOfRuleFactory("eosio"), "The {field} field must not be eosio"))
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should pass first rule", "6", "The test field must be a valid EOS name"},
+		{"should pass second rule", "eosio", "The test field must not be eosio"},
+
+		{"valid", "bob", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestOr(t *testing.T) {
+	tag := "reference"
+	rule := Or(EOSNameRule, EOSBlockNumRule)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should pass any rule", "EOS-1", "The test field must be a valid EOS name; The test field must be a valid EOS block num"},
+		{"should pass any rule type", true, "The test field is not a known type for an EOS name; The test field is not a known type for an EOS block num"},
+
+		{"valid first", "eosio", ""},
+		{"valid second", "6789", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	assert.NoError(t, Or()("test", tag, "", "anything"))
+
+	err := validator("test", "EOS-1")
+	assert.True(t, errors.Is(err, ErrInvalidEOSName))
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "invalid_eos_name", validationErr.Code)
+}
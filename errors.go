@@ -0,0 +1,366 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors wrapped by the `ValidationError` returned by rules, usable with
+// `errors.Is(err, validator.ErrNotHex)`.
+var (
+	ErrInvalid                     = errors.New("invalid")
+	ErrInvalidType                 = errors.New("invalid type")
+	ErrTooFewElements              = errors.New("too few elements")
+	ErrDuplicateElements           = errors.New("duplicate elements")
+	ErrTooManyElements             = errors.New("too many elements")
+	ErrOutOfRange                  = errors.New("out of range")
+	ErrTooLong                     = errors.New("too long")
+	ErrInvalidLength               = errors.New("invalid length")
+	ErrOddLength                   = errors.New("odd length")
+	ErrInvalidChecksum             = errors.New("invalid checksum")
+	ErrNotHex                      = errors.New("not hex")
+	ErrNotBase58                   = errors.New("not base58")
+	ErrNotBase64URL                = errors.New("not base64url")
+	ErrInvalidChoice               = errors.New("invalid choice")
+	ErrInvalidCursor               = errors.New("invalid cursor")
+	ErrInvalidFormat               = errors.New("invalid format")
+	ErrInvalidDateTime             = errors.New("invalid date time")
+	ErrInvalidUnixTimestamp        = errors.New("invalid unix timestamp")
+	ErrInvalidChecksum256          = errors.New("invalid checksum256")
+	ErrNotPermitted                = errors.New("not permitted")
+	ErrUnsupportedCursorVersion    = errors.New("unsupported cursor version")
+	ErrInvalidDuration             = errors.New("invalid duration")
+	ErrEOSAccountNotFound          = errors.New("EOS account not found")
+	ErrInvalidEOSActionName        = errors.New("invalid EOS action name")
+	ErrInvalidEOSAuthority         = errors.New("invalid EOS authority")
+	ErrInvalidEOSABI               = errors.New("invalid EOS ABI")
+	ErrInvalidEOSAsset             = errors.New("invalid EOS asset")
+	ErrInvalidEOSBlockRange        = errors.New("invalid EOS block range")
+	ErrInvalidEOSBlockID           = errors.New("invalid EOS block id")
+	ErrInvalidEOSBlockNum          = errors.New("invalid EOS block num")
+	ErrInvalidEOSChainID           = errors.New("invalid EOS chain id")
+	ErrInvalidEOSContractAction    = errors.New("invalid EOS contract action")
+	ErrInvalidEOSCreatableAccount  = errors.New("invalid EOS creatable account name")
+	ErrInvalidEOSExtendedAsset     = errors.New("invalid EOS extended asset")
+	ErrInvalidEOSExtendedName      = errors.New("invalid EOS extended name")
+	ErrInvalidEOSExtendedSymbol    = errors.New("invalid EOS extended symbol")
+	ErrInvalidEOSName              = errors.New("invalid EOS name")
+	ErrInvalidEOSNameOrBlockNum    = errors.New("invalid EOS name or block num")
+	ErrInvalidEOSPackedTransaction = errors.New("invalid EOS packed transaction")
+	ErrInvalidEOSPermissionLevel   = errors.New("invalid EOS permission level")
+	ErrInvalidEOSPublicKey         = errors.New("invalid EOS public key")
+	ErrInvalidEOSRAMBytes          = errors.New("invalid EOS RAM byte count")
+	ErrInvalidEOSRelativeBlock     = errors.New("invalid EOS block reference")
+	ErrInvalidEOSScope             = errors.New("invalid EOS table scope")
+	ErrInvalidEOSSignature         = errors.New("invalid EOS signature")
+	ErrInvalidEOSSymbol            = errors.New("invalid EOS symbol")
+	ErrInvalidEOSSymbolCode        = errors.New("invalid EOS symbol code")
+	ErrInvalidEOSThreshold         = errors.New("invalid EOS authority threshold")
+	ErrInvalidEOSTimePointSec      = errors.New("invalid EOS time point")
+	ErrInvalidEOSVarInt32          = errors.New("invalid EOS varint32")
+	ErrInvalidEOSVarUint32         = errors.New("invalid EOS varuint32")
+	ErrInvalidEOSWeight            = errors.New("invalid EOS authority weight")
+)
+
+var codeErrors = map[string]error{
+	"invalid":                        ErrInvalid,
+	"invalid_type":                   ErrInvalidType,
+	"too_few_elements":               ErrTooFewElements,
+	"duplicate_elements":             ErrDuplicateElements,
+	"too_many_elements":              ErrTooManyElements,
+	"out_of_range":                   ErrOutOfRange,
+	"too_long":                       ErrTooLong,
+	"invalid_length":                 ErrInvalidLength,
+	"odd_length":                     ErrOddLength,
+	"invalid_checksum":               ErrInvalidChecksum,
+	"not_hex":                        ErrNotHex,
+	"not_base58":                     ErrNotBase58,
+	"not_base64url":                  ErrNotBase64URL,
+	"invalid_choice":                 ErrInvalidChoice,
+	"invalid_cursor":                 ErrInvalidCursor,
+	"invalid_format":                 ErrInvalidFormat,
+	"invalid_date_time":              ErrInvalidDateTime,
+	"invalid_unix_timestamp":         ErrInvalidUnixTimestamp,
+	"invalid_checksum256":            ErrInvalidChecksum256,
+	"eos_account_not_found":          ErrEOSAccountNotFound,
+	"invalid_eos_authority":          ErrInvalidEOSAuthority,
+	"invalid_eos_abi":                ErrInvalidEOSABI,
+	"invalid_eos_asset":              ErrInvalidEOSAsset,
+	"invalid_eos_block_range":        ErrInvalidEOSBlockRange,
+	"invalid_eos_block_id":           ErrInvalidEOSBlockID,
+	"invalid_eos_block_num":          ErrInvalidEOSBlockNum,
+	"invalid_eos_extended_name":      ErrInvalidEOSExtendedName,
+	"invalid_eos_name":               ErrInvalidEOSName,
+	"invalid_eos_name_or_block_num":  ErrInvalidEOSNameOrBlockNum,
+	"invalid_eos_packed_transaction": ErrInvalidEOSPackedTransaction,
+	"invalid_eos_permission_level":   ErrInvalidEOSPermissionLevel,
+	"invalid_eos_public_key":         ErrInvalidEOSPublicKey,
+	"invalid_eos_relative_block":     ErrInvalidEOSRelativeBlock,
+	"invalid_eos_scope":              ErrInvalidEOSScope,
+	"invalid_eos_signature":          ErrInvalidEOSSignature,
+	"invalid_eos_symbol":             ErrInvalidEOSSymbol,
+	"invalid_eos_symbol_code":        ErrInvalidEOSSymbolCode,
+	"invalid_eos_time_point_sec":     ErrInvalidEOSTimePointSec,
+	"not_permitted":                  ErrNotPermitted,
+	"invalid_eos_varint32":           ErrInvalidEOSVarInt32,
+	"invalid_eos_varuint32":          ErrInvalidEOSVarUint32,
+	"invalid_eos_chain_id":           ErrInvalidEOSChainID,
+	"invalid_eos_action_name":        ErrInvalidEOSActionName,
+	"invalid_eos_contract_action":    ErrInvalidEOSContractAction,
+	"unsupported_cursor_version":     ErrUnsupportedCursorVersion,
+	"invalid_eos_weight":             ErrInvalidEOSWeight,
+	"invalid_eos_threshold":          ErrInvalidEOSThreshold,
+	"invalid_eos_creatable_account":  ErrInvalidEOSCreatableAccount,
+	"invalid_eos_ram_bytes":          ErrInvalidEOSRAMBytes,
+	"invalid_duration":               ErrInvalidDuration,
+	"invalid_eos_extended_symbol":    ErrInvalidEOSExtendedSymbol,
+	"invalid_eos_extended_asset":     ErrInvalidEOSExtendedAsset,
+}
+
+// ValidationError is the error returned by every rule of this package. The `Code`
+// is a stable machine-readable identifier of the failure (i.e. `invalid_eos_name`,
+// `not_hex`, `too_many_elements`) while `Message` is the human-readable text.
+type ValidationError struct {
+	Field   string
+	Tag     string
+	Code    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the sentinel error matching the error's `Code` (i.e. `ErrNotHex`
+// for `not_hex`), so `errors.Is` can be used to check for a specific failure.
+func (e *ValidationError) Unwrap() error {
+	return codeErrors[e.Code]
+}
+
+// ValidationErrors aggregates the errors of multiple fields, keyed by field name.
+type ValidationErrors map[string]error
+
+// Error joins the error messages of all fields, sorted by field name, with `; `.
+func (e ValidationErrors) Error() string {
+	fields := make([]string, 0, len(e))
+	for field := range e {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fieldLess(fields[i], fields[j]) })
+
+	messages := make([]string, len(fields))
+	for i, field := range fields {
+		messages[i] = e[field].Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether the error of any field matches `target`, so `errors.Is` can
+// be used to check for a specific failure (i.e. `ErrInvalidEOSName`).
+func (e ValidationErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldLess orders field names alphabetically, except for the indexes of list
+// elements which are compared numerically (i.e. `names[2]` before `names[10]`).
+func fieldLess(a, b string) bool {
+	for a != "" && b != "" {
+		digitsA, digitsB := leadingDigits(a), leadingDigits(b)
+		if digitsA != "" && digitsB != "" {
+			if len(digitsA) != len(digitsB) {
+				return len(digitsA) < len(digitsB)
+			}
+
+			if digitsA != digitsB {
+				return digitsA < digitsB
+			}
+
+			a, b = a[len(digitsA):], b[len(digitsB):]
+			continue
+		}
+
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+
+		a, b = a[1:], b[1:]
+	}
+
+	return len(a) < len(b)
+}
+
+func leadingDigits(input string) string {
+	end := 0
+	for end < len(input) && input[end] >= '0' && input[end] <= '9' {
+		end++
+	}
+
+	return input[:end]
+}
+
+// FieldViolation describes why a field is invalid, it maps directly to the
+// `google.rpc.BadRequest.FieldViolation` message of gRPC rich errors.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// FieldViolations lists the offending fields of a validation error, either a single
+// `*ValidationError` or aggregated `ValidationErrors`, sorted by field name. It
+// returns nil when `err` is not a validation error.
+func FieldViolations(err error) []FieldViolation {
+	var errs ValidationErrors
+	if errors.As(err, &errs) {
+		violations := make([]FieldViolation, 0, len(errs))
+		for field, fieldErr := range errs {
+			violations = append(violations, FieldViolation{Field: field, Description: fieldErr.Error()})
+		}
+
+		sort.Slice(violations, func(i, j int) bool { return fieldLess(violations[i].Field, violations[j].Field) })
+		return violations
+	}
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return []FieldViolation{{Field: validationErr.Field, Description: validationErr.Message}}
+	}
+
+	return nil
+}
+
+var errorCodes = map[string]string{
+	"base58":                  "not_base58",
+	"base58.checksum":         "invalid_checksum",
+	"base64url":               "not_base64url",
+	"byte_length_range.max":   "too_long",
+	"byte_length_range.min":   "invalid_length",
+	"cursor":                  "invalid_cursor",
+	"cursor.length":           "too_long",
+	"cursor.version":          "unsupported_cursor_version",
+	"date_time":               "invalid_date_time",
+	"duration":                "invalid_duration",
+	"duration_range":          "out_of_range",
+	"eos_account_creatable":   "invalid_eos_creatable_account",
+	"eos_account_exists":      "eos_account_not_found",
+	"eos_abi":                 "invalid_eos_abi",
+	"eos_action_name":         "invalid_eos_action_name",
+	"eos_asset":               "invalid_eos_asset",
+	"eos_asset.negative":      "out_of_range",
+	"eos_asset.symbol":        "invalid_eos_asset",
+	"eos_authority":           "invalid_eos_authority",
+	"eos_authority.threshold": "invalid_eos_authority",
+	"eos_authority.weights":   "invalid_eos_authority",
+	"eos_block_range":         "invalid_eos_block_range",
+	"eos_block_range.order":   "invalid_eos_block_range",
+	"eos_block_range.span":    "out_of_range",
+	"eos_block_id":            "invalid_eos_block_id",
+	"eos_block_num":           "invalid_eos_block_num",
+	"eos_block_num_range":     "out_of_range",
+	"eos_chain_id":            "invalid_eos_chain_id",
+	"eos_chain_id.allowed":    "not_permitted",
+	"eos_checksum256":         "invalid_checksum256",
+	"eos_contract_action":     "invalid_eos_contract_action",
+	"eos_extended_asset":      "invalid_eos_extended_asset",
+	"eos_extended_name":       "invalid_eos_extended_name",
+	"eos_extended_symbol":     "invalid_eos_extended_symbol",
+	"eos_memo":                "too_long",
+	"eos_name":                "invalid_eos_name",
+	"eos_name_allowlist":      "not_permitted",
+	"eos_name_denylist":       "not_permitted",
+	"eos_name_or_block_num":   "invalid_eos_name_or_block_num",
+	"eos_name_prefix":         "invalid_format",
+	"eos_name_suffix":         "invalid_format",
+	"eos_packed_transaction":  "invalid_eos_packed_transaction",
+	"eos_permission_level":    "invalid_eos_permission_level",
+	"eos_public_key":          "invalid_eos_public_key",
+	"eos_ram_bytes":           "invalid_eos_ram_bytes",
+	"eos_relative_block":      "invalid_eos_relative_block",
+	"eos_scope":               "invalid_eos_scope",
+	"eos_signature":           "invalid_eos_signature",
+	"eos_symbol":              "invalid_eos_symbol",
+	"eos_symbol.mismatch":     "invalid_eos_symbol",
+	"eos_symbol_code":         "invalid_eos_symbol_code",
+	"eos_threshold":           "invalid_eos_threshold",
+	"eos_time_point_sec":      "invalid_eos_time_point_sec",
+	"eos_trx_id.length":       "invalid_length",
+	"eos_varint32":            "invalid_eos_varint32",
+	"eos_varuint32":           "invalid_eos_varuint32",
+	"eos_weight":              "invalid_eos_weight",
+	"exact_length":            "invalid_length",
+	"hex":                     "not_hex",
+	"hex.length":              "odd_length",
+	"hex_exact_length":        "invalid_length",
+	"hex_slice.total_bytes":   "too_long",
+	"int_range":               "out_of_range",
+	"int_range.integer":       "invalid",
+	"length_range.max":        "too_long",
+	"length_range.min":        "invalid_length",
+	"list.max":                "too_many_elements",
+	"list.unique":             "duplicate_elements",
+	"list.min":                "too_few_elements",
+	"max_length.max":          "too_long",
+	"min_length.min":          "invalid_length",
+	"one_of":                  "invalid_choice",
+	"regex":                   "invalid_format",
+	"time_range":              "out_of_range",
+	"unix_timestamp":          "invalid_unix_timestamp",
+}
+
+// errorCode returns the code of the message `key`, keys not explicitly listed
+// fall back on their sub-key (`.type` gives `invalid_type`, `.min` gives `too_few_elements`, etc.).
+func errorCode(key string) string {
+	if code, found := errorCodes[key]; found {
+		return code
+	}
+
+	switch {
+	case strings.HasSuffix(key, ".type"):
+		return "invalid_type"
+	case strings.HasSuffix(key, ".min"):
+		return "too_few_elements"
+	case strings.HasSuffix(key, ".max"):
+		return "too_many_elements"
+	}
+
+	return "invalid"
+}
+
+// newError creates the error returned by a rule, the message override registered
+// for `key` is used if present, `format` otherwise. The `format` must start with
+// `The %s field`, rendered by the field formatter, followed by `args`.
+func newError(key string, field string, tag string, format string, args ...interface{}) error {
+	messagesLock.RLock()
+	template, found := messages[key]
+	formatField := fieldFormatter
+	messagesLock.RUnlock()
+
+	message := ""
+	if found {
+		message = strings.Replace(template, "{field}", field, -1)
+	} else {
+		format = "%s" + strings.TrimPrefix(format, fieldPlaceholder)
+		message = fmt.Sprintf(format, append([]interface{}{formatField(field)}, args...)...)
+	}
+
+	return &ValidationError{Field: field, Tag: tag, Code: errorCode(key), Message: message}
+}
+
+// newTemplateError creates the error of a rule whose message is supplied by the caller,
+// with its `{field}` placeholder replaced by the field name.
+func newTemplateError(key string, field string, tag string, template string) error {
+	return &ValidationError{
+		Field:   field,
+		Tag:     tag,
+		Code:    errorCode(key),
+		Message: strings.Replace(template, "{field}", field, -1),
+	}
+}
@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		expectedError *ValidationError
+	}{
+		{
+			"eos_name",
+			EOSNameRule("test", "eos_name", "", "6"),
+			&ValidationError{Field: "test", Tag: "eos_name", Code: "invalid_eos_name", Message: "The test field must be a valid EOS name"},
+		},
+		{
+			"eos_name type",
+			EOSNameRule("test", "eos_name", "", true),
+			&ValidationError{Field: "test", Tag: "eos_name", Code: "invalid_type", Message: "The test field is not a known type for an EOS name"},
+		},
+		{
+			"hex",
+			HexRule("test", "hex", "", "zz"),
+			&ValidationError{Field: "test", Tag: "hex", Code: "not_hex", Message: "The test field must be a valid hexadecimal"},
+		},
+		{
+			"hex odd length",
+			HexRule("test", "hex", "", "abc"),
+			&ValidationError{Field: "test", Tag: "hex", Code: "odd_length", Message: "The test field must have an even number of characters"},
+		},
+		{
+			"list max",
+			EOSNamesListRuleFactory("|", 1)("test", "eos_names_list", "", "a|b"),
+			&ValidationError{Field: "test", Tag: "eos_names_list", Code: "too_many_elements", Message: "The test field must have at most 1 element"},
+		},
+		{
+			"list element",
+			EOSNamesListRuleFactory("|", 2)("test", "eos_names_list", "", "a|6"),
+			&ValidationError{Field: "test[1]", Tag: "eos_names_list", Code: "invalid_eos_name", Message: "The test[1] field must be a valid EOS name"},
+		},
+		{
+			"hex slice min",
+			HexSliceRule("test", "hex_slice", "", []string{}),
+			&ValidationError{Field: "test", Tag: "hex_slice", Code: "too_few_elements", Message: "The test field must have at least 1 element"},
+		},
+		{
+			"parse block num",
+			func() error { _, err := ParseBlockNum("a"); return err }(),
+			&ValidationError{Field: "value", Tag: "eos_block_num", Code: "invalid_eos_block_num", Message: "The value field must be a valid EOS block num"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Error(t, test.err)
+			assert.Equal(t, test.expectedError, test.err)
+			assert.Equal(t, test.expectedError.Message, test.err.Error())
+		})
+	}
+}
+
+func TestValidationError_Is(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{"eos_name", EOSNameRule("test", "eos_name", "", "6"), ErrInvalidEOSName},
+		{"eos_name type", EOSNameRule("test", "eos_name", "", true), ErrInvalidType},
+		{"hex", HexRule("test", "hex", "", "zz"), ErrNotHex},
+		{"hex odd length", HexRule("test", "hex", "", "abc"), ErrOddLength},
+		{"list max", EOSNamesListRuleFactory("|", 1)("test", "eos_names_list", "", "a|b"), ErrTooManyElements},
+		{"list element", EOSNamesListRuleFactory("|", 2)("test", "eos_names_list", "", "a|6"), ErrInvalidEOSName},
+		{"block num range", EOSBlockNumRangeRuleFactory(1, 10)("test", "eos_block_num_range", "", "11"), ErrOutOfRange},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.True(t, errors.Is(test.err, test.expectedErr))
+			assert.False(t, errors.Is(test.err, ErrInvalid))
+
+			var validationErr *ValidationError
+			assert.True(t, errors.As(test.err, &validationErr))
+		})
+	}
+}
+
+func TestFieldViolations(t *testing.T) {
+	tests := []struct {
+		name               string
+		err                error
+		expectedViolations []FieldViolation
+	}{
+		{
+			"single",
+			EOSNameRule("account", "eos_name", "", "6"),
+			[]FieldViolation{{Field: "account", Description: "The account field must be a valid EOS name"}},
+		},
+		{
+			"aggregated",
+			ValidationErrors{
+				"data":    HexRule("data", "hex", "", "zz"),
+				"account": EOSNameRule("account", "eos_name", "", "6"),
+			},
+			[]FieldViolation{
+				{Field: "account", Description: "The account field must be a valid EOS name"},
+				{Field: "data", Description: "The data field must be a valid hexadecimal"},
+			},
+		},
+		{"other error", errors.New("boom"), nil},
+		{"nil", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedViolations, FieldViolations(test.err))
+		})
+	}
+}
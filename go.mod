@@ -7,4 +7,7 @@ require (
 	github.com/eoscanada/eos-go v0.9.1-0.20200415144303-2adb25bcdeca
 	github.com/stretchr/testify v1.4.0
 	github.com/thedevsaddam/govalidator v1.9.6
+	golang.org/x/crypto v0.0.0-20191002192127-34f69633bfdc
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
+	google.golang.org/grpc v1.29.1
 )
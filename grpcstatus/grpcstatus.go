@@ -0,0 +1,45 @@
+// Package grpcstatus maps validation errors to gRPC statuses. It lives apart from
+// the `validator` package so that only gRPC services depend on gRPC.
+package grpcstatus
+
+import (
+	"github.com/dfuse-io/validator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ToGRPCStatus maps `err` to a gRPC status. Validation errors, a single
+// `*validator.ValidationError` or aggregated `validator.ValidationErrors`, become
+// `codes.InvalidArgument` with a `BadRequest` detail listing the field violations.
+// Errors already carrying a gRPC status are passed through, any other error becomes
+// `codes.Internal`. A nil `err` gives an `OK` status.
+func ToGRPCStatus(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	violations := validator.FieldViolations(err)
+	if violations == nil {
+		if st, ok := status.FromError(err); ok {
+			return st
+		}
+
+		return status.New(codes.Internal, err.Error())
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, err.Error())
+	if detailed, err := st.WithDetails(badRequest); err == nil {
+		return detailed
+	}
+
+	return st
+}
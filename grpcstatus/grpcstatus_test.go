@@ -0,0 +1,72 @@
+package grpcstatus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dfuse-io/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToGRPCStatus(t *testing.T) {
+	tests := []struct {
+		name               string
+		err                error
+		expectedCode       codes.Code
+		expectedMessage    string
+		expectedViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			"single",
+			validator.EOSNameRule("account", "eos_name", "", "6"),
+			codes.InvalidArgument,
+			"The account field must be a valid EOS name",
+			[]*errdetails.BadRequest_FieldViolation{
+				{Field: "account", Description: "The account field must be a valid EOS name"},
+			},
+		},
+		{
+			"aggregated",
+			validator.ValidationErrors{
+				"data":    validator.HexRule("data", "hex", "", "zz"),
+				"account": validator.EOSNameRule("account", "eos_name", "", "6"),
+			},
+			codes.InvalidArgument,
+			"The account field must be a valid EOS name; The data field must be a valid hexadecimal",
+			[]*errdetails.BadRequest_FieldViolation{
+				{Field: "account", Description: "The account field must be a valid EOS name"},
+				{Field: "data", Description: "The data field must be a valid hexadecimal"},
+			},
+		},
+		{"status error", status.Error(codes.NotFound, "not found"), codes.NotFound, "not found", nil},
+		{"other error", errors.New("boom"), codes.Internal, "boom", nil},
+		{"nil", nil, codes.OK, "", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			st := ToGRPCStatus(test.err)
+			assert.Equal(t, test.expectedCode, st.Code())
+			assert.Equal(t, test.expectedMessage, st.Message())
+
+			if test.expectedViolations == nil {
+				assert.Empty(t, st.Details())
+				return
+			}
+
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+
+			require.Len(t, badRequest.FieldViolations, len(test.expectedViolations))
+			for i, expected := range test.expectedViolations {
+				assert.Equal(t, expected.Field, badRequest.FieldViolations[i].Field)
+				assert.Equal(t, expected.Description, badRequest.FieldViolations[i].Description)
+			}
+		})
+	}
+}
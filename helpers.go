@@ -1,16 +1,76 @@
 package validator
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/btcsuite/btcutil/base58"
+	"golang.org/x/crypto/ripemd160"
 )
 
-var symbolRegexp = regexp.MustCompile(`^[0-9],[A-Z]{1,7}$`)
+// parseField is the field name used in errors returned by the `Parse*` helpers
+const parseField = "value"
+
+var blockNumKeywords = map[string]bool{
+	"head":              true,
+	"LIB":               true,
+	"last_irreversible": true,
+}
+
 var symbolCodeRegexp = regexp.MustCompile(`^[A-Z]{1,7}$`)
-var nameRegexp = regexp.MustCompile(`^[\.a-z1-5]{0,13}$`)
 
+// nameChars and nameLastChars are the ASCII characters allowed in a name, the 13th
+// character of a name is encoded on 4 bits only, restricting it to `.1-5a-j`
+var nameChars = newCharTable(".12345abcdefghijklmnopqrstuvwxyz")
+var nameLastChars = newCharTable(".12345abcdefghij")
+
+// creatableNameChars are the characters allowed in an account name created without
+// a premium name bid, dots are reserved to premium names.
+var creatableNameChars = newCharTable("12345abcdefghijklmnopqrstuvwxyz")
+
+var checksum256Regexp = regexp.MustCompile(`^[A-Fa-f0-9]{64}$`)
+var base58Regexp = regexp.MustCompile(`^[1-9A-HJ-NP-Za-km-z]+$`)
+var assetRegexp = regexp.MustCompile(`^-?[0-9]+(?:\.([0-9]+))? (?:([0-9]{1,2}),)?([A-Z]{1,7})$`)
+
+// cut slices input around the first instance of sep, returning the text before
+// and after it, `found` is false when sep does not appear in input. It mirrors
+// `strings.Cut`, unavailable with the Go version this module targets.
+func cut(input string, sep string) (before string, after string, found bool) {
+	if i := strings.Index(input, sep); i >= 0 {
+		return input[:i], input[i+len(sep):], true
+	}
+
+	return input, "", false
+}
+
+func newCharTable(chars string) (table [256]bool) {
+	for i := 0; i < len(chars); i++ {
+		table[chars[i]] = true
+	}
+
+	return
+}
+
+// ExplodeNames splits input on `sep`, dropping empty and whitespace only elements
+// so a trailing separator (`eos|eosio|`) doesn't produce a spurious element.
 func ExplodeNames(input string, sep string) (names []string) {
+	return explodeList(input, sep, true)
+}
+
+// explodeList splits input on `sep`, dropping empty and whitespace only elements
+// when `skipEmpty` is true.
+func explodeList(input string, sep string, skipEmpty bool) (names []string) {
 	rawNames := strings.Split(input, sep)
+	if !skipEmpty {
+		return rawNames
+	}
+
 	for _, rawName := range rawNames {
 		account := strings.TrimSpace(rawName)
 		if account == "" {
@@ -23,21 +83,519 @@ func ExplodeNames(input string, sep string) (names []string) {
 	return
 }
 
-// FIXME: Use eso-go IsValidName once merged, not perfect Regex for now, 13 characters if present is restricted to a different subset
+// IsBlockNumKeyword checks that input is one of the symbolic block references
+// accepted in place of a concrete block num (`head`, `LIB` or `last_irreversible`).
+func IsBlockNumKeyword(input string) bool {
+	return blockNumKeywords[input]
+}
+
+// FIXME: Use eso-go IsValidName once merged
 func IsValidName(input string) bool {
 	// An empty string name means a uint64 transformed name with a 0 value
 	if input == "" {
 		return true
 	}
 
-	return nameRegexp.MatchString(input)
+	if len(input) > 13 {
+		return false
+	}
+
+	for i := 0; i < len(input); i++ {
+		if i == 12 {
+			return nameLastChars[input[i]]
+		}
+
+		if !nameChars[input[i]] {
+			return false
+		}
+	}
+
+	return true
 }
 
+// IsCreatableAccountName checks that input is an account name the system contract
+// lets anyone create: exactly 12 characters among `a-z` and `1-5`, without dots.
+func IsCreatableAccountName(input string) bool {
+	if len(input) != 12 {
+		return false
+	}
+
+	for i := 0; i < len(input); i++ {
+		if !creatableNameChars[input[i]] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasEdgeOrConsecutiveDots checks if input starts or ends with a dot or contains
+// two consecutive dots, which the system contract disallows for new accounts.
+func HasEdgeOrConsecutiveDots(input string) bool {
+	return strings.HasPrefix(input, ".") || strings.HasSuffix(input, ".") || strings.Contains(input, "..")
+}
+
+// IsValidExtendedName checks that input is empty or a name, symbol code or symbol.
 func IsValidExtendedName(input string) bool {
 	// An empty string name means a uint64 transformed name with a 0 value
 	if input == "" {
 		return true
 	}
 
-	return nameRegexp.MatchString(input) || symbolCodeRegexp.MatchString(input) || symbolRegexp.MatchString(input)
+	return IsValidName(input) || IsValidSymbolCode(input) || IsValidSymbol(input)
+}
+
+// IsValidAsset checks that input is an EOS asset string like `1.0000 EOS`. The
+// symbol precision can also be given explicitly (`1.0000 4,EOS`), in which case
+// the amount must have exactly that number of decimal places.
+func IsValidAsset(input string) bool {
+	matches := assetRegexp.FindStringSubmatch(input)
+	if matches == nil {
+		return false
+	}
+
+	decimals, rawPrecision := matches[1], matches[2]
+	if rawPrecision == "" {
+		return len(decimals) <= 18
+	}
+
+	precision, err := strconv.ParseUint(rawPrecision, 10, 8)
+	if err != nil || precision > 18 {
+		return false
+	}
+
+	return uint64(len(decimals)) == precision
+}
+
+// assetSymbol returns the symbol of an asset already validated by `IsValidAsset`,
+// its precision being the explicit one if present, the decimals count otherwise.
+func assetSymbol(input string) eos.Symbol {
+	matches := assetRegexp.FindStringSubmatch(input)
+	decimals, rawPrecision, code := matches[1], matches[2], matches[3]
+
+	precision := uint64(len(decimals))
+	if rawPrecision != "" {
+		precision, _ = strconv.ParseUint(rawPrecision, 10, 8)
+	}
+
+	return eos.Symbol{Precision: uint8(precision), Symbol: code}
+}
+
+// IsValidExtendedAsset checks that input is an asset qualified by the contract
+// issuing it like `1.0000 EOS@eosio.token`, split on the last `@`. The contract
+// must be a non-empty valid name.
+func IsValidExtendedAsset(input string) bool {
+	i := strings.LastIndex(input, "@")
+	if i == -1 {
+		return false
+	}
+
+	contract := input[i+1:]
+	return contract != "" && IsValidName(contract) && IsValidAsset(input[:i])
+}
+
+// IsValidSymbol checks that input is an EOS symbol like `4,EOS`, the precision
+// must be between 0 and 18 and the code made of 1 to 7 uppercase letters.
+func IsValidSymbol(input string) bool {
+	rawPrecision, code, found := cut(input, ",")
+	if !found || len(rawPrecision) == 0 || len(rawPrecision) > 2 {
+		return false
+	}
+
+	precision, err := strconv.ParseUint(rawPrecision, 10, 8)
+	if err != nil {
+		return false
+	}
+
+	return precision <= 18 && IsValidSymbolCode(code)
+}
+
+// IsValidExtendedSymbol checks that input is a symbol qualified by the contract
+// issuing it like `4,EOS@eosio.token`, the contract must be a non-empty valid name.
+func IsValidExtendedSymbol(input string) bool {
+	symbol, contract, found := cut(input, "@")
+	if !found || contract == "" || strings.Contains(contract, "@") {
+		return false
+	}
+
+	return IsValidSymbol(symbol) && IsValidName(contract)
+}
+
+// publicKeyCurveSizes maps the curves of the `PUB_<curve>_` formats to the size of
+// their key material, `WebAuthn` keys carry extra data after the 33 bytes key.
+var publicKeyCurveSizes = map[string]int{"K1": 33, "R1": 33, "WA": 33}
+
+// IsValidPublicKey checks that input is an EOS public key, either in the legacy
+// `EOS...` format or in one of the `PUB_K1_...`, `PUB_R1_...` and `PUB_WA_...`
+// formats. The base58 key material must end with its RIPEMD-160 checksum, computed
+// over the key followed by the curve name for the `PUB_` formats.
+func IsValidPublicKey(input string) bool {
+	curve, material, checksumSuffix := "K1", "", ""
+	switch {
+	case strings.HasPrefix(input, "EOS"):
+		material = input[len("EOS"):]
+	case strings.HasPrefix(input, "PUB_"):
+		parts := strings.SplitN(input[len("PUB_"):], "_", 2)
+		if len(parts) != 2 {
+			return false
+		}
+
+		curve, material, checksumSuffix = parts[0], parts[1], parts[0]
+	default:
+		return false
+	}
+
+	size, known := publicKeyCurveSizes[curve]
+	if !known {
+		return false
+	}
+
+	decoded := base58.Decode(material)
+	if len(decoded) < size+4 || (curve != "WA" && len(decoded) != size+4) {
+		return false
+	}
+
+	key, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+
+	return bytes.Equal(ripemd160Checksum(key, checksumSuffix), checksum)
+}
+
+// ripemd160Checksum returns the first 4 bytes of the RIPEMD-160 digest of payload
+// followed by suffix.
+func ripemd160Checksum(payload []byte, suffix string) []byte {
+	hasher := ripemd160.New()
+	_, _ = hasher.Write(payload)
+	_, _ = hasher.Write([]byte(suffix))
+
+	return hasher.Sum(nil)[:4]
+}
+
+// IsValidSymbolCode checks that input is an EOS symbol code like `EOS`, made of
+// 1 to 7 uppercase letters.
+func IsValidSymbolCode(input string) bool {
+	return symbolCodeRegexp.MatchString(input)
+}
+
+// IsValidChecksum256 checks that input is the hexadecimal representation of a
+// 32 bytes digest, i.e. exactly 64 hexadecimal characters.
+func IsValidChecksum256(input string) bool {
+	return checksum256Regexp.MatchString(input)
+}
+
+// ParseEOSName validates `value` like `EOSNameRule` does and returns it as an
+// `eos.Name`, a nil pointer value gives back an empty name.
+func ParseEOSName(value interface{}) (eos.Name, error) {
+	if err := EOSNameRule(parseField, "eos_name", "", value); err != nil {
+		return "", err
+	}
+
+	value, present := deref(value, "")
+	if !present {
+		return "", nil
+	}
+
+	return eos.Name(fmt.Sprintf("%s", value)), nil
+}
+
+// ParseBlockNum validates `value` like `EOSBlockNumRule` does and returns the
+// block num it represents.
+func ParseBlockNum(value interface{}) (uint32, error) {
+	value, present := deref(value, "")
+	if !present {
+		return 0, nil
+	}
+
+	if err := EOSBlockNumRule(parseField, "eos_block_num", "", value); err != nil {
+		return 0, err
+	}
+
+	raw, _ := integerString(value)
+	blockNum, _ := strconv.ParseUint(raw, 10, 32)
+
+	return uint32(blockNum), nil
+}
+
+// ParseHex validates `value` like `HexRule` does and returns the decoded bytes,
+// `[]byte` values are returned as-is.
+func ParseHex(value interface{}) ([]byte, error) {
+	if err := HexRule(parseField, "hex", "", value); err != nil {
+		return nil, err
+	}
+
+	value, present := deref(value, "")
+	if !present {
+		return nil, nil
+	}
+
+	if raw, ok := value.([]byte); ok {
+		return raw, nil
+	}
+
+	return hex.DecodeString(value.(string))
+}
+
+// ParseTrxID validates `value` like `EOSTrxIDRule` does and returns the transaction
+// id as lowercase hexadecimal, so ids differing only by case compare equal.
+func ParseTrxID(value interface{}) (string, error) {
+	if err := EOSTrxIDRule(parseField, "eos_trx_id", "", value); err != nil {
+		return "", err
+	}
+
+	value, present := deref(value, "")
+	if !present {
+		return "", nil
+	}
+
+	if checksum, ok := value.(eos.Checksum256); ok {
+		return hex.EncodeToString(checksum), nil
+	}
+
+	return strings.ToLower(hexString(value)), nil
+}
+
+// ParseCursor validates `value` like `CursorRule` does and returns the raw bytes
+// the opaque cursor decodes to, an empty cursor gives back nil.
+func ParseCursor(value string) ([]byte, error) {
+	decoded, err := decodeCursor(parseField, "cursor", value, 0)
+	if err != nil || decoded == "" {
+		return nil, err
+	}
+
+	return []byte(decoded), nil
+}
+
+// CursorVersion returns the version of a decoded cursor (see `ParseCursor`), read
+// from its `cN:` prefix (i.e. `c3:...` is version 3). Legacy cursors without such
+// prefix are version 1.
+func CursorVersion(decoded []byte) int {
+	prefix, _, found := cut(string(decoded), ":")
+	if !found || len(prefix) < 2 || prefix[0] != 'c' {
+		return 1
+	}
+
+	version, err := strconv.ParseUint(prefix[1:], 10, 8)
+	if err != nil {
+		return 1
+	}
+
+	return int(version)
+}
+
+// ParseBlockID extracts the block num encoded in the first 4 bytes (big-endian)
+// of an EOS block ID. The block ID must be 64 hexadecimal characters and the
+// embedded block num must not be 0.
+func ParseBlockID(input string) (uint32, error) {
+	if !IsValidChecksum256(input) {
+		return 0, fmt.Errorf("block id %q must be exactly 64 hexadecimal characters", input)
+	}
+
+	blockNum, err := strconv.ParseUint(input[0:8], 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("block id %q has an invalid block num prefix: %w", input, err)
+	}
+
+	if blockNum == 0 {
+		return 0, fmt.Errorf("block id %q has a 0 block num prefix", input)
+	}
+
+	return uint32(blockNum), nil
+}
+
+// ParseRelativeBlock resolves a block reference accepted by `EOSRelativeBlockRule`
+// to a concrete block num, `head-N` and `LIB-N` being resolved relative to the
+// given `head` and `lib` block nums. The resolved block num must be at least 1.
+func ParseRelativeBlock(input string, head, lib uint32) (uint32, error) {
+	if EOSBlockNumRule(parseField, "eos_block_num", "", input) == nil {
+		blockNum, _ := strconv.ParseUint(input, 10, 32)
+		return uint32(blockNum), nil
+	}
+
+	reference, offset, ok := splitRelativeBlock(input)
+	if !ok {
+		return 0, fmt.Errorf("block reference %q must be a block num, head, head-N or LIB-N", input)
+	}
+
+	blockNum := head
+	if reference == "LIB" {
+		blockNum = lib
+	}
+
+	if offset >= blockNum {
+		return 0, fmt.Errorf("block reference %q resolves before the first block", input)
+	}
+
+	return blockNum - offset, nil
+}
+
+// splitRelativeBlock splits a `head`, `head-N`, `LIB` or `LIB-N` block reference
+// in its reference keyword and its offset, `ok` is false if input is not one of them.
+func splitRelativeBlock(input string) (reference string, offset uint32, ok bool) {
+	reference, rawOffset, found := cut(input, "-")
+	if found {
+		if EOSBlockNumRule(parseField, "eos_block_num", "", rawOffset) != nil {
+			return "", 0, false
+		}
+	}
+
+	if reference != "head" && reference != "LIB" {
+		return "", 0, false
+	}
+
+	if rawOffset != "" {
+		value, _ := strconv.ParseUint(rawOffset, 10, 32)
+		offset = uint32(value)
+	}
+
+	return reference, offset, true
+}
+
+// ParseBlockRange parses an inclusive `low-high` block range like `1000-2000`, both
+// ends must be valid block nums and `low` must not be greater than `high`.
+func ParseBlockRange(input string) (low uint32, high uint32, err error) {
+	low, high, ok := splitBlockRange(input)
+	if !ok {
+		return 0, 0, fmt.Errorf("block range %q must be two block nums separated by a dash", input)
+	}
+
+	if low > high {
+		return 0, 0, fmt.Errorf("block range %q low block num must not be greater than its high block num", input)
+	}
+
+	return low, high, nil
+}
+
+// splitBlockRange splits a `low-high` block range, `ok` is false if either end is
+// not a valid block num. The order of the ends is not checked.
+func splitBlockRange(input string) (low uint32, high uint32, ok bool) {
+	lowPart, highPart, found := cut(input, "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	for _, part := range []string{lowPart, highPart} {
+		if EOSBlockNumRule(parseField, "eos_block_num", "", part) != nil {
+			return 0, 0, false
+		}
+	}
+
+	rawLow, _ := strconv.ParseUint(lowPart, 10, 32)
+	rawHigh, _ := strconv.ParseUint(highPart, 10, 32)
+
+	return uint32(rawLow), uint32(rawHigh), true
+}
+
+// ParseContractAction parses a `contract:action` pair like `eosio.token:transfer`,
+// the contract must be a non-empty valid name and the action a valid action name.
+func ParseContractAction(input string) (eos.AccountName, eos.ActionName, error) {
+	contract, action, found := cut(input, ":")
+	if !found || contract == "" || !IsValidName(contract) || EOSActionNameRule(parseField, "eos_action_name", "", action) != nil {
+		return "", "", fmt.Errorf("contract action %q must be a contract name and an action name separated by a colon", input)
+	}
+
+	return eos.AccountName(contract), eos.ActionName(action), nil
+}
+
+// IsValidPermissionLevel checks that input is an EOS permission level like
+// `eosio@active`, both the actor and the permission must be non-empty valid names.
+func IsValidPermissionLevel(input string) bool {
+	actor, permission, found := cut(input, "@")
+	if !found || actor == "" || permission == "" || strings.Contains(permission, "@") {
+		return false
+	}
+
+	return IsValidName(actor) && IsValidName(permission)
+}
+
+// IsValidBase64URL checks that input is a base64url encoded string (RFC 4648
+// section 5), padding is optional but must be correct when present.
+func IsValidBase64URL(input string) bool {
+	encoding := base64.RawURLEncoding
+	if strings.HasSuffix(input, "=") {
+		encoding = base64.URLEncoding
+	}
+
+	_, err := encoding.DecodeString(input)
+	return err == nil
+}
+
+// IsValidBase58 checks that input is a non-empty string made only of characters
+// from the Bitcoin base58 alphabet.
+func IsValidBase58(input string) bool {
+	return base58Regexp.MatchString(input)
+}
+
+// IsValidBase58Check checks that input is valid base58 whose decoded bytes end
+// with a 4 bytes checksum, the first 4 bytes of the RIPEMD-160 digest of the
+// payload, like EOS legacy key material.
+func IsValidBase58Check(input string) bool {
+	if !IsValidBase58(input) {
+		return false
+	}
+
+	decoded := base58.Decode(input)
+	if len(decoded) <= 4 {
+		return false
+	}
+
+	payload, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+
+	hasher := ripemd160.New()
+	_, _ = hasher.Write(payload)
+
+	return bytes.Equal(hasher.Sum(nil)[:4], checksum)
+}
+
+// IsValidABI checks the structural integrity of an ABI: a supported `eosio::abi/1.x`
+// version, unique struct names, and actions and tables whose type is a declared
+// struct (directly or through a type alias).
+func IsValidABI(abi eos.ABI) bool {
+	if !strings.HasPrefix(abi.Version, "eosio::abi/1.") {
+		return false
+	}
+
+	structs := map[string]bool{}
+	for _, structDef := range abi.Structs {
+		if structDef.Name == "" || structs[structDef.Name] {
+			return false
+		}
+
+		structs[structDef.Name] = true
+	}
+
+	aliases := map[string]string{}
+	for _, abiType := range abi.Types {
+		aliases[abiType.NewTypeName] = abiType.Type
+	}
+
+	isDeclaredStruct := func(typeName string) bool {
+		// Bounded by the aliases count to not loop forever on cyclic aliases
+		for i := 0; i <= len(aliases); i++ {
+			if structs[typeName] {
+				return true
+			}
+
+			alias, found := aliases[typeName]
+			if !found {
+				return false
+			}
+
+			typeName = alias
+		}
+
+		return false
+	}
+
+	for _, action := range abi.Actions {
+		if !isDeclaredStruct(action.Type) {
+			return false
+		}
+	}
+
+	for _, table := range abi.Tables {
+		if !isDeclaredStruct(table.Type) {
+			return false
+		}
+	}
+
+	return true
 }
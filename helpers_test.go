@@ -0,0 +1,329 @@
+package validator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dfuse-io/opaque"
+	"github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEOSName(t *testing.T) {
+	validName := "eosio"
+
+	tests := []struct {
+		name          string
+		input         interface{}
+		expected      eos.Name
+		expectedError string
+	}{
+		{"invalid type", true, "", "The value field is not a known type for an EOS name"},
+		{"invalid name", "6", "", "The value field must be a valid EOS name"},
+
+		{"valid string", "eosio.token", eos.Name("eosio.token"), ""},
+		{"valid empty", "", eos.Name(""), ""},
+		{"valid *string", &validName, eos.Name("eosio"), ""},
+		{"valid nil *string", (*string)(nil), eos.Name(""), ""},
+		{"valid eos.AccountName", eos.AccountName("eosio"), eos.Name("eosio"), ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseEOSName(test.input)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseBlockNum(t *testing.T) {
+	validBlockNum := "10"
+
+	tests := []struct {
+		name          string
+		input         interface{}
+		expected      uint32
+		expectedError string
+	}{
+		{"invalid type", true, 0, "The value field is not a known type for an EOS block num"},
+		{"invalid string", "a", 0, "The value field must be a valid EOS block num"},
+		{"overflow string", "4294967296", 0, "The value field must be a valid EOS block num"},
+		{"leading zeros string", "007", 0, "The value field must be a valid EOS block num"},
+		{"negative int", -1, 0, "The value field must be a valid EOS block num"},
+		{"overflow int", 4294967296, 0, "The value field must be a valid EOS block num"},
+		{"invalid json.Number", json.Number("1.5"), 0, "The value field must be a valid EOS block num"},
+		{"non-integral float64", 10.5, 0, "The value field must be a valid EOS block num"},
+
+		{"valid string", "10", 10, ""},
+		{"valid max string", "4294967295", 4294967295, ""},
+		{"valid *string", &validBlockNum, 10, ""},
+		{"valid int", 10, 10, ""},
+		{"valid uint32", uint32(10), 10, ""},
+		{"valid json.Number", json.Number("10"), 10, ""},
+		{"valid integral float64", 10.0, 10, ""},
+		{"valid integral json.Number", json.Number("10.0"), 10, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseBlockNum(test.input)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	validHex := "ab01"
+
+	tests := []struct {
+		name          string
+		input         interface{}
+		expected      []byte
+		expectedError string
+	}{
+		{"invalid type", true, nil, "The value field must be a string"},
+		{"invalid characters", "zz", nil, "The value field must be a valid hexadecimal"},
+		{"odd length", "abc", nil, "The value field must have an even number of characters"},
+		{"prefixed", "0xab", nil, "The value field must be a valid hexadecimal"},
+
+		{"valid string", "ab01", []byte{0xab, 0x01}, ""},
+		{"valid uppercase", "AB01", []byte{0xab, 0x01}, ""},
+		{"valid *string", &validHex, []byte{0xab, 0x01}, ""},
+		{"valid bytes", []byte{0xab, 0x01}, []byte{0xab, 0x01}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseHex(test.input)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseTrxID(t *testing.T) {
+	trxID := "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148"
+	trxIDBytes, _ := hex.DecodeString(trxID)
+
+	tests := []struct {
+		name          string
+		input         interface{}
+		expected      string
+		expectedError string
+	}{
+		{"invalid type", true, "", "The value field must be a string"},
+		{"invalid characters", "zz", "", "The value field must be a valid hexadecimal"},
+		{"invalid length", "abcd", "", "The value field must have exactly 64 characters"},
+
+		{"valid", trxID, trxID, ""},
+		{"valid uppercase", strings.ToUpper(trxID), trxID, ""},
+		{"valid bytes", trxIDBytes, trxID, ""},
+		{"valid eos.Checksum256", eos.Checksum256(trxIDBytes), trxID, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseTrxID(test.input)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseCursor(t *testing.T) {
+	cursor, err := opaque.ToOpaque("c1:1:100:abc:0")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      []byte
+		expectedError string
+	}{
+		{"invalid characters", "-----==", nil, "The value field is not a valid cursor"},
+		{"invalid cursor", "abc", nil, "The value field is not a valid cursor"},
+
+		{"valid", cursor, []byte("c1:1:100:abc:0"), ""},
+		{"valid empty", "", nil, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseCursor(test.input)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestCursorVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{"legacy", "1:100:abc", 1},
+		{"legacy without separator", "abcdef", 1},
+		{"invalid version", "cx:100:abc", 1},
+		{"v1", "c1:100:abc", 1},
+		{"v3", "c3:100:abc", 3},
+		{"v12", "c12:100:abc", 12},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, CursorVersion([]byte(test.input)))
+		})
+	}
+}
+
+func TestParseBlockID(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      uint32
+		expectedError string
+	}{
+		{"invalid hex", "0000000az408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", 0, `block id "0000000az408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148" must be exactly 64 hexadecimal characters`},
+		{"zero block num", "000000001408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", 0, `block id "000000001408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148" has a 0 block num prefix`},
+
+		{"valid", "0000000a1408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", 10, ""},
+		{"valid max", "ffffffff1408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", 4294967295, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseBlockID(test.input)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseRelativeBlock(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      uint32
+		expectedError string
+	}{
+		{"block num", "10", 10, ""},
+		{"head", "head", 1000, ""},
+		{"head offset", "head-100", 900, ""},
+		{"LIB", "LIB", 800, ""},
+		{"LIB offset", "LIB-10", 790, ""},
+		{"resolves to first block", "head-999", 1, ""},
+
+		{"invalid", "tail-10", 0, `block reference "tail-10" must be a block num, head, head-N or LIB-N`},
+		{"invalid extra dash", "head-1-2", 0, `block reference "head-1-2" must be a block num, head, head-N or LIB-N`},
+		{"before first block", "head-1000", 0, `block reference "head-1000" resolves before the first block`},
+		{"before first block LIB", "LIB-900", 0, `block reference "LIB-900" resolves before the first block`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blockNum, err := ParseRelativeBlock(test.input, 1000, 800)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, blockNum)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseBlockRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedLow   uint32
+		expectedHigh  uint32
+		expectedError string
+	}{
+		{"valid", "1000-2000", 1000, 2000, ""},
+		{"valid single block", "10-10", 10, 10, ""},
+
+		{"invalid", "1000", 0, 0, `block range "1000" must be two block nums separated by a dash`},
+		{"invalid block num", "a-10", 0, 0, `block range "a-10" must be two block nums separated by a dash`},
+		{"invalid extra dash", "1-2-3", 0, 0, `block range "1-2-3" must be two block nums separated by a dash`},
+		{"invalid order", "20-10", 0, 0, `block range "20-10" low block num must not be greater than its high block num`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			low, high, err := ParseBlockRange(test.input)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedLow, low)
+				assert.Equal(t, test.expectedHigh, high)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseContractAction(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            string
+		expectedContract eos.AccountName
+		expectedAction   eos.ActionName
+		expectedError    string
+	}{
+		{"valid", "eosio.token:transfer", "eosio.token", "transfer", ""},
+
+		{"invalid", "eosio.token", "", "", `contract action "eosio.token" must be a contract name and an action name separated by a colon`},
+		{"invalid extra colon", "a:b:c", "", "", `contract action "a:b:c" must be a contract name and an action name separated by a colon`},
+		{"invalid empty contract", ":transfer", "", "", `contract action ":transfer" must be a contract name and an action name separated by a colon`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contract, action, err := ParseContractAction(test.input)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedContract, contract)
+				assert.Equal(t, test.expectedAction, action)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
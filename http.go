@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// WriteValidationError writes `err` as a `400 Bad Request` JSON response of the
+// form `{"errors": {"field": "message", ...}}` when it's a validation error, either
+// a single `*ValidationError` or aggregated `ValidationErrors`. It returns false,
+// without writing anything, for any other error so the caller can handle it.
+func WriteValidationError(w http.ResponseWriter, err error) bool {
+	fields := validationErrorFields(err)
+	if fields == nil {
+		return false
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": fields})
+	return true
+}
+
+// validationErrorFields maps each offending field of a validation error to its
+// message, it returns nil when `err` is not a validation error.
+func validationErrorFields(err error) map[string]string {
+	violations := FieldViolations(err)
+	if violations == nil {
+		return nil
+	}
+
+	fields := make(map[string]string, len(violations))
+	for _, violation := range violations {
+		fields[violation.Field] = violation.Description
+	}
+
+	return fields
+}
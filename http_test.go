@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteValidationError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedOK     bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			"single",
+			EOSNameRule("account", "eos_name", "", "6"),
+			true,
+			http.StatusBadRequest,
+			`{"errors":{"account":"The account field must be a valid EOS name"}}` + "\n",
+		},
+		{
+			"aggregated",
+			ValidationErrors{
+				"account": EOSNameRule("account", "eos_name", "", "6"),
+				"data":    HexRule("data", "hex", "", "zz"),
+			},
+			true,
+			http.StatusBadRequest,
+			`{"errors":{"account":"The account field must be a valid EOS name","data":"The data field must be a valid hexadecimal"}}` + "\n",
+		},
+		{
+			"list elements",
+			ListRuleFactoryAll("|", 3, EOSNameRule)("names", "eos_names_list_all", "", "6|ab|7"),
+			true,
+			http.StatusBadRequest,
+			`{"errors":{"names[0]":"The names[0] field must be a valid EOS name","names[2]":"The names[2] field must be a valid EOS name"}}` + "\n",
+		},
+		{
+			"other error",
+			errors.New("boom"),
+			false,
+			http.StatusOK,
+			"",
+		},
+		{
+			"nil",
+			nil,
+			false,
+			http.StatusOK,
+			"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			ok := WriteValidationError(recorder, test.err)
+			assert.Equal(t, test.expectedOK, ok)
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+			assert.Equal(t, test.expectedBody, recorder.Body.String())
+
+			if test.expectedOK {
+				assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
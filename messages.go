@@ -0,0 +1,56 @@
+package validator
+
+import (
+	"fmt"
+	"sync"
+)
+
+// fieldPlaceholder is how every built-in message starts, it's rendered by the field
+// formatter.
+const fieldPlaceholder = "The %s field"
+
+var messagesLock sync.RWMutex
+var messages = map[string]string{}
+var fieldFormatter = defaultFieldFormatter
+
+// SetMessage overrides the error message returned by the rule identified by `tag`
+// (i.e. `eos_name`). Rules returning multiple messages use sub-keys for the
+// secondary ones, like `hex.length` for odd-length hexadecimal or `hex.type` when
+// the value is not of a supported type. The `{field}` placeholder of `template`
+// is replaced by the field name. An empty `template` restores the built-in message.
+//
+// It's safe to call concurrently with rules validation, but it's meant to be
+// configured once at startup.
+func SetMessage(tag string, template string) {
+	messagesLock.Lock()
+	defer messagesLock.Unlock()
+
+	if template == "" {
+		delete(messages, tag)
+		return
+	}
+
+	messages[tag] = template
+}
+
+// SetFieldFormatter overrides how the field is rendered at the start of the built-in
+// messages, `The <field> field` by default. For example, returning a humanized label
+// gives `Account Name must be a valid EOS name`. A nil `formatter` restores the
+// default. Messages overridden through `SetMessage` are not affected.
+//
+// Like `SetMessage`, it's safe to call concurrently with rules validation, but it's
+// meant to be configured once at startup.
+func SetFieldFormatter(formatter func(field string) string) {
+	messagesLock.Lock()
+	defer messagesLock.Unlock()
+
+	if formatter == nil {
+		formatter = defaultFieldFormatter
+	}
+
+	fieldFormatter = formatter
+}
+
+func defaultFieldFormatter(field string) string {
+	return fmt.Sprintf(fieldPlaceholder, field)
+}
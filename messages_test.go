@@ -0,0 +1,58 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMessage(t *testing.T) {
+	SetMessage("eos_name", "Oops, {field} is not an account name")
+	SetMessage("hex.length", "Odd {field}")
+	defer SetMessage("eos_name", "")
+	defer SetMessage("hex.length", "")
+
+	assert.EqualError(t, EOSNameRule("test", "eos_name", "", "6"), "Oops, test is not an account name")
+	assert.EqualError(t, EOSNameRule("test", "eos_name", "", true), "The test field is not a known type for an EOS name")
+	assert.EqualError(t, EOSNamesListRuleFactory("|", 2)("test", "eos_names_list", "", "eos|6"), "Oops, test[1] is not an account name")
+
+	assert.EqualError(t, HexRule("test", "hex", "", "abc"), "Odd test")
+	assert.EqualError(t, HexRule("test", "hex", "", "zz"), "The test field must be a valid hexadecimal")
+}
+
+func TestSetMessage_Restore(t *testing.T) {
+	SetMessage("eos_name", "Oops")
+	SetMessage("eos_name", "")
+
+	assert.EqualError(t, EOSNameRule("test", "eos_name", "", "6"), "The test field must be a valid EOS name")
+}
+
+func TestSetFieldFormatter(t *testing.T) {
+	SetFieldFormatter(func(field string) string { return strings.Title(strings.Replace(field, "_", " ", -1)) })
+	defer SetFieldFormatter(nil)
+
+	assert.EqualError(t, EOSNameRule("account_name", "eos_name", "", "6"), "Account Name must be a valid EOS name")
+	assert.EqualError(t, EOSNamesListRuleFactory("|", 1)("names", "eos_names_list", "", "a|b"), "Names must have at most 1 element")
+
+	err := HexRule("raw_data", "hex", "", "zz")
+	assert.EqualError(t, err, "Raw Data must be a valid hexadecimal")
+	assert.Equal(t, "raw_data", err.(*ValidationError).Field)
+}
+
+func TestSetFieldFormatter_MessageOverride(t *testing.T) {
+	SetFieldFormatter(func(field string) string { return field })
+	SetMessage("eos_name", "Oops, {field} is not an account name")
+	defer SetFieldFormatter(nil)
+	defer SetMessage("eos_name", "")
+
+	assert.EqualError(t, EOSNameRule("test", "eos_name", "", "6"), "Oops, test is not an account name")
+	assert.EqualError(t, EOSNameRule("test", "eos_name", "", true), "test is not a known type for an EOS name")
+}
+
+func TestSetFieldFormatter_Restore(t *testing.T) {
+	SetFieldFormatter(func(field string) string { return field })
+	SetFieldFormatter(nil)
+
+	assert.EqualError(t, EOSNameRule("test", "eos_name", "", "6"), "The test field must be a valid EOS name")
+}
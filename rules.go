@@ -1,151 +1,2018 @@
 package validator
 
 import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ecc"
 	"github.com/dfuse-io/opaque"
 )
 
+// maxUnixTimestamp is the last second of year 9999, anything above is considered
+// an absurd unix timestamp.
+const maxUnixTimestamp = 253402300799
+
+// eosTimePointSecLayout is the layout used by nodeos to serialize `time_point_sec`
+// values, always in UTC and without any timezone offset.
+const eosTimePointSecLayout = "2006-01-02T15:04:05"
+
+// Rule is a validation rule. The rules returned by the factories of this package
+// only capture immutable state, so they can be registered once and safely called
+// from many goroutines concurrently.
 type Rule func(field string, rule string, message string, value interface{}) error
 
-func EOSBlockNumRule(field string, rule string, message string, value interface{}) error {
-	val, ok := value.(string)
-	if !ok {
-		return fmt.Errorf("The %s field must be a string", field)
+// RuleFunc is the plain function signature of a rule, as expected by govalidator's
+// `AddCustomRule`. Any `Rule` can be used where a `RuleFunc` is expected.
+type RuleFunc = func(field string, rule string, message string, value interface{}) error
+
+// CtxRuleFunc is the signature of rules needing a `context.Context`, typically
+// because they perform I/O (i.e. checking an account exists on chain).
+type CtxRuleFunc = func(ctx context.Context, field string, rule string, message string, value interface{}) error
+
+// WithoutContext adapts a synchronous rule to the `CtxRuleFunc` signature, the
+// context is ignored.
+func WithoutContext(ruleFunc RuleFunc) CtxRuleFunc {
+	return func(ctx context.Context, field string, rule string, message string, value interface{}) error {
+		return ruleFunc(field, rule, message, value)
+	}
+}
+
+// DefaultRules maps the canonical tag name of each rule of this package to its
+// implementation. Factory based rules are registered with sensible defaults,
+// lists are `|` separated with at most 10 elements and date times are RFC3339.
+var DefaultRules = map[string]RuleFunc{
+	"eos_block_num":          EOSBlockNumRule,
+	"eos_relative_block":     EOSRelativeBlockRule,
+	"eos_block_range":        EOSBlockRangeRule,
+	"eos_name":               EOSNameRule,
+	"eos_account_creatable":  EOSAccountCreatableRule,
+	"eos_action_name":        EOSActionNameRule,
+	"eos_contract_action":    EOSContractActionRule,
+	"eos_name_or_block_num":  EOSNameOrBlockNumRule,
+	"eos_extended_name":      EOSExtendedNameRule,
+	"eos_asset":              EOSAssetRule,
+	"eos_extended_asset":     EOSExtendedAssetRule,
+	"eos_symbol":             EOSSymbolRule,
+	"eos_extended_symbol":    EOSExtendedSymbolRule,
+	"eos_symbol_code":        EOSSymbolCodeRule,
+	"eos_public_key":         EOSPublicKeyRule,
+	"eos_signature":          EOSSignatureRule,
+	"eos_signature_slice":    EOSSignatureSliceRule,
+	"eos_permission_level":   EOSPermissionLevelRule,
+	"eos_authority":          EOSAuthorityRule,
+	"eos_weight":             EOSWeightRule,
+	"eos_threshold":          EOSThresholdRule,
+	"eos_ram_bytes":          EOSRAMBytesRule,
+	"eos_abi":                EOSABIRule,
+	"eos_packed_transaction": EOSPackedTransactionRule,
+	"eos_memo":               EOSMemoRule,
+	"eos_scope":              EOSScopeRule,
+	"eos_trx_id":             EOSTrxIDRule,
+	"eos_checksum256":        EOSChecksum256Rule,
+	"eos_block_id":           EOSBlockIDRule,
+	"eos_chain_id":           EOSChainIDRule,
+	"eos_varint32":           EOSVarInt32Rule,
+	"eos_varuint32":          EOSVarUint32Rule,
+	"eos_time_point_sec":     EOSTimePointSecRule,
+	"cursor":                 CursorRule,
+	"base64url":              Base64URLRule,
+	"base58":                 Base58Rule,
+	"hex":                    HexRule,
+	"hex_slice":              HexSliceRule,
+	"duration":               DurationRule,
+
+	"eos_names_list":          EOSNamesListRuleFactory("|", 10),
+	"eos_extended_names_list": EOSExtendedNamesListRuleFactory("|", 10),
+	"eos_public_keys_list":    EOSPublicKeyListRuleFactory("|", 10),
+	"eos_trx_ids_list":        EOSTrxIDListRuleFactory("|", 10),
+	"eos_assets_list":         EOSAssetListRuleFactory("|", 10),
+	"eos_symbols_list":        EOSSymbolListRuleFactory("|", 10),
+	"date_time":               DateTimeRuleFactory(time.RFC3339),
+}
+
+// integerString returns the decimal representation of the numeric types found in
+// decoded payloads (`json.Number` with `UseNumber()`, `float64` otherwise) so numeric
+// rules validate them like their string form. A float with a fractional part gives
+// a non-integer representation, rejected by the rules. A `json.Number` written as a
+// float (i.e. `10.0`) is formatted like the equivalent `float64`. It returns false
+// for any other type.
+func integerString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		if !strings.ContainsAny(string(v), ".eE") {
+			return string(v), true
+		}
+
+		float, err := v.Float64()
+		if err != nil {
+			return string(v), true
+		}
+
+		return strconv.FormatFloat(float, 'f', -1, 64), true
+	case int:
+		return strconv.FormatInt(int64(v), 10), true
+	case int32:
+		return strconv.FormatInt(int64(v), 10), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint64:
+		return strconv.FormatUint(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+// deref unwraps pointer values so that optional struct fields (i.e. `*string`) are
+// validated like their pointed value. It returns `false` when the pointer is nil,
+// the value is then considered absent and rules accept it. A nil interface value
+// is replaced by `empty` so it's validated like an empty value of the rule's type.
+func deref(value interface{}, empty interface{}) (interface{}, bool) {
+	if value == nil {
+		return empty, true
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr {
+		return value, true
+	}
+
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, false
+		}
+
+		rv = rv.Elem()
+	}
+
+	return rv.Interface(), true
+}
+
+// EOSBlockNumRule validates a block num, a decimal string or an integer (including
+// `json.Number` and integral `float64` values) between 0 and 4294967295.
+func EOSBlockNumRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	val, ok := integerString(value)
+	if !ok {
+		return newError("eos_block_num.type", field, rule, "The %s field is not a known type for an EOS block num")
+	}
+
+	// Leading zeros are rejected, a block num has a single canonical representation
+	if len(val) > 1 && val[0] == '0' {
+		return newError("eos_block_num", field, rule, "The %s field must be a valid EOS block num")
+	}
+
+	_, err := strconv.ParseUint(val, 10, 32)
+	if err != nil {
+		return newError("eos_block_num", field, rule, "The %s field must be a valid EOS block num")
+	}
+
+	return nil
+}
+
+// EOSBlockNumRuleFactory is like `EOSBlockNumRule` but when `allowKeywords` is
+// true, it also accepts the symbolic block references `head`, `LIB` and
+// `last_irreversible`.
+func EOSBlockNumRuleFactory(allowKeywords bool) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		if val, ok := value.(string); ok && allowKeywords && IsBlockNumKeyword(val) {
+			return nil
+		}
+
+		return EOSBlockNumRule(field, rule, message, value)
+	}
+}
+
+// EOSVarUint32Rule validates a `varuint32` ABI value, a decimal string or an integer
+// between 0 and 4294967295.
+func EOSVarUint32Rule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	if _, ok := value.(eos.Varuint32); ok {
+		return nil
+	}
+
+	val, ok := integerString(value)
+	if !ok {
+		return newError("eos_varuint32.type", field, rule, "The %s field is not a known type for a varuint32")
+	}
+
+	if _, err := strconv.ParseUint(val, 10, 32); err != nil {
+		return newError("eos_varuint32", field, rule, "The %s field must be a valid varuint32")
+	}
+
+	return nil
+}
+
+// EOSVarInt32Rule validates a `varint32` ABI value, a decimal string or an integer
+// between -2147483648 and 2147483647, the range whose zigzag encoding fits 32 bits.
+func EOSVarInt32Rule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	if _, ok := value.(eos.Varint32); ok {
+		return nil
+	}
+
+	val, ok := integerString(value)
+	if !ok {
+		return newError("eos_varint32.type", field, rule, "The %s field is not a known type for a varint32")
+	}
+
+	if _, err := strconv.ParseInt(val, 10, 32); err != nil {
+		return newError("eos_varint32", field, rule, "The %s field must be a valid varint32")
+	}
+
+	return nil
+}
+
+// EOSRelativeBlockRule validates a block reference, either a plain block num or a
+// block relative to head or LIB (`head`, `head-N`, `LIB` or `LIB-N`), see
+// `ParseRelativeBlock` to resolve it.
+func EOSRelativeBlockRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	val, ok := value.(string)
+	if !ok {
+		return newError("eos_relative_block.type", field, rule, "The %s field must be a string")
+	}
+
+	if EOSBlockNumRule(field, rule, message, val) == nil {
+		return nil
+	}
+
+	if _, _, ok := splitRelativeBlock(val); !ok {
+		return newError("eos_relative_block", field, rule, "The %s field must be a valid block reference")
+	}
+
+	return nil
+}
+
+// EOSBlockRangeRule validates an inclusive `low-high` block range of any span.
+func EOSBlockRangeRule(field string, rule string, message string, value interface{}) error {
+	return EOSBlockRangeRuleFactory(0)(field, rule, message, value)
+}
+
+// EOSBlockRangeRuleFactory creates a rule validating an inclusive `low-high` block
+// range (see `ParseBlockRange`) spanning at most `maxSpan` blocks, 0 meaning no limit.
+func EOSBlockRangeRuleFactory(maxSpan uint32) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		val, ok := value.(string)
+		if !ok {
+			return newError("eos_block_range.type", field, rule, "The %s field must be a string")
+		}
+
+		low, high, ok := splitBlockRange(val)
+		if !ok {
+			return newError("eos_block_range", field, rule, "The %s field must be a valid block range")
+		}
+
+		if low > high {
+			return newError("eos_block_range.order", field, rule, "The %s field range is invalid")
+		}
+
+		if maxSpan > 0 && uint64(high)-uint64(low)+1 > uint64(maxSpan) {
+			return newError("eos_block_range.span", field, rule, "The %s field range exceeds %d blocks", maxSpan)
+		}
+
+		return nil
+	}
+}
+
+// EOSBlockNumRangeRuleFactory validates a block num within `min` and `max` inclusive.
+func EOSBlockNumRangeRuleFactory(min, max uint32) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := EOSBlockNumRule(field, rule, message, value)
+		if err != nil {
+			return err
+		}
+
+		blockNum, _ := ParseBlockNum(value)
+		if blockNum < min || blockNum > max {
+			return newError("eos_block_num_range", field, rule, "The %s field must be between %d and %d", min, max)
+		}
+
+		return nil
+	}
+}
+
+// EOSNameRule validates an EOS name, as a string or one of the `eos` name types.
+func EOSNameRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkName := func(field string, name string) error {
+		if !IsValidName(name) {
+			return newError("eos_name", field, rule, "The %s field must be a valid EOS name")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkName(field, v)
+	case eos.Name:
+		return checkName(field, string(v))
+	case eos.PermissionName:
+		return checkName(field, string(v))
+	case eos.ActionName:
+		return checkName(field, string(v))
+	case eos.AccountName:
+		return checkName(field, string(v))
+	case eos.TableName:
+		return checkName(field, string(v))
+	default:
+		return newError("eos_name.type", field, rule, "The %s field is not a known type for an EOS name")
+	}
+}
+
+// EOSActionNameRule validates an action name, encoded like any EOS name but
+// reported as an action name. Unlike `EOSNameRule`, an empty name is rejected.
+func EOSActionNameRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	var name string
+	switch v := value.(type) {
+	case string:
+		name = v
+	case eos.ActionName:
+		name = string(v)
+	case eos.Name:
+		name = string(v)
+	default:
+		return newError("eos_action_name.type", field, rule, "The %s field is not a known type for an EOS action name")
+	}
+
+	if name == "" || !IsValidName(name) {
+		return newError("eos_action_name", field, rule, "The %s field must be a valid EOS action name")
+	}
+
+	return nil
+}
+
+// EOSContractActionRule validates a `contract:action` pair like `eosio.token:transfer`,
+// see `ParseContractAction` to extract both names.
+func EOSContractActionRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	val, ok := value.(string)
+	if !ok {
+		return newError("eos_contract_action.type", field, rule, "The %s field must be a string")
+	}
+
+	if _, _, err := ParseContractAction(val); err != nil {
+		return newError("eos_contract_action", field, rule, "The %s field must be a valid contract:action")
+	}
+
+	return nil
+}
+
+// EOSNameRuleFactory is like `EOSNameRule` but when `allowEdgeDots` is false, names
+// starting or ending with a dot or containing consecutive dots are rejected.
+func EOSNameRuleFactory(allowEdgeDots bool) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := EOSNameRule(field, rule, message, value)
+		if err != nil || allowEdgeDots {
+			return err
+		}
+
+		if HasEdgeOrConsecutiveDots(fmt.Sprintf("%s", value)) {
+			return newError("eos_name", field, rule, "The %s field must be a valid EOS name")
+		}
+
+		return nil
+	}
+}
+
+// EOSAccountCreatableRule validates a new account name against the system contract
+// rules for regular (non-premium) accounts, see `IsCreatableAccountName`.
+func EOSAccountCreatableRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	var name string
+	switch v := value.(type) {
+	case string:
+		name = v
+	case eos.AccountName:
+		name = string(v)
+	case eos.Name:
+		name = string(v)
+	default:
+		return newError("eos_account_creatable.type", field, rule, "The %s field is not a known type for an EOS account name")
+	}
+
+	if !IsCreatableAccountName(name) {
+		return newError("eos_account_creatable", field, rule, "The %s field is not a creatable account name")
+	}
+
+	return nil
+}
+
+// EOSNameAllowlistRuleFactory is like `EOSNameRule` but also rejects any name
+// not part of `allowed`.
+func EOSNameAllowlistRuleFactory(allowed ...string) Rule {
+	return eosNameSetRuleFactory("eos_name_allowlist", allowed, true)
+}
+
+// EOSNameDenylistRuleFactory is like `EOSNameRule` but also rejects any name
+// part of `denied`.
+func EOSNameDenylistRuleFactory(denied ...string) Rule {
+	return eosNameSetRuleFactory("eos_name_denylist", denied, false)
+}
+
+func eosNameSetRuleFactory(key string, names []string, allow bool) Rule {
+	nameSet := make(map[string]bool, len(names))
+	for _, name := range names {
+		nameSet[name] = true
+	}
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := EOSNameRule(field, rule, message, value)
+		if err != nil {
+			return err
+		}
+
+		name := fmt.Sprintf("%s", value)
+		if nameSet[name] != allow {
+			return newError(key, field, rule, "The %s field account is not permitted")
+		}
+
+		return nil
+	}
+}
+
+// EOSNameSuffixRuleFactory is like `EOSNameRule` but also requires the name to be
+// a sub-account of `suffix`, i.e. to end with `.<suffix>` (`alice.myapp` for `myapp`).
+func EOSNameSuffixRuleFactory(suffix string) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := EOSNameRule(field, rule, message, value)
+		if err != nil {
+			return err
+		}
+
+		name := fmt.Sprintf("%s", value)
+		if len(name) <= len(suffix)+1 || !strings.HasSuffix(name, "."+suffix) {
+			return newError("eos_name_suffix", field, rule, "The %s field must be a sub-account of %s", suffix)
+		}
+
+		return nil
+	}
+}
+
+// EOSNamePrefixRuleFactory is like `EOSNameRule` but also requires the name to
+// start with `<prefix>.` (`myapp.alice` for `myapp`).
+func EOSNamePrefixRuleFactory(prefix string) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := EOSNameRule(field, rule, message, value)
+		if err != nil {
+			return err
+		}
+
+		name := fmt.Sprintf("%s", value)
+		if len(name) <= len(prefix)+1 || !strings.HasPrefix(name, prefix+".") {
+			return newError("eos_name_prefix", field, rule, "The %s field must start with %s.", prefix)
+		}
+
+		return nil
+	}
+}
+
+// EOSNameOrBlockNumRule accepts either a valid EOS name or a valid block num, the
+// latter possibly given as an integer (see `EOSBlockNumRule`). The sub-rules errors
+// are not leaked, a single combined message is reported instead.
+func EOSNameOrBlockNumRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	switch value.(type) {
+	case string, eos.Name, eos.PermissionName, eos.ActionName, eos.AccountName, eos.TableName:
+		if EOSNameRule(field, rule, message, value) == nil || EOSBlockNumRule(field, rule, message, value) == nil {
+			return nil
+		}
+	default:
+		if _, ok := integerString(value); !ok {
+			return newError("eos_name_or_block_num.type", field, rule, "The %s field is not a known type for an EOS name or block num")
+		}
+
+		if EOSBlockNumRule(field, rule, message, value) == nil {
+			return nil
+		}
+	}
+
+	return newError("eos_name_or_block_num", field, rule, "The %s field must be a valid EOS name or block num")
+}
+
+// EOSAccountGetter is the subset of `*eos.API` needed by `EOSAccountExistsRuleFactory`.
+type EOSAccountGetter interface {
+	GetAccount(ctx context.Context, name eos.AccountName) (*eos.AccountResp, error)
+}
+
+// AccountLookupError is returned by `EOSAccountExistsRuleFactory` when the account
+// could not be retrieved for another reason than it not existing (i.e. network
+// error), it's not a `ValidationError` so it can be reported as unavailability.
+type AccountLookupError struct {
+	Account string
+	Err     error
+}
+
+func (e *AccountLookupError) Error() string {
+	return fmt.Sprintf("unable to retrieve account %q: %s", e.Account, e.Err)
+}
+
+func (e *AccountLookupError) Unwrap() error {
+	return e.Err
+}
+
+// EOSAccountExistsRuleFactory creates a rule validating that the value is an EOS
+// name of an account existing on chain, retrieved through `client` (usually an
+// `*eos.API`) with the rule's context.
+func EOSAccountExistsRuleFactory(client EOSAccountGetter) CtxRuleFunc {
+	return func(ctx context.Context, field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		if err := EOSNameRule(field, rule, message, value); err != nil {
+			return err
+		}
+
+		account := fmt.Sprintf("%s", value)
+		_, err := client.GetAccount(ctx, eos.AccountName(account))
+		if err != nil {
+			if isAccountNotFound(err) {
+				return newError("eos_account_exists", field, rule, "The %s field account does not exist")
+			}
+
+			return &AccountLookupError{Account: account, Err: err}
+		}
+
+		return nil
+	}
+}
+
+// isAccountNotFound recognizes the errors returned by `get_account` for an unknown
+// account, eos-go already maps the `unknown key` error of older nodeos versions to
+// `eos.ErrNotFound` while recent ones return an `account_query_exception`.
+func isAccountNotFound(err error) bool {
+	if errors.Is(err, eos.ErrNotFound) {
+		return true
+	}
+
+	var apiErr eos.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorStruct.Code == 3060002
+}
+
+// EOSExtendedNameRule validates an EOS name, symbol code or symbol.
+func EOSExtendedNameRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkName := func(field string, name string) error {
+		if !IsValidExtendedName(name) {
+			return newError("eos_extended_name", field, rule, "The %s field must be a valid EOS name")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkName(field, v)
+	case eos.Symbol:
+		return checkName(field, v.String())
+	case eos.SymbolCode:
+		return checkName(field, v.String())
+	case eos.Name, eos.PermissionName, eos.ActionName, eos.AccountName, eos.TableName:
+		return checkName(field, fmt.Sprintf("%s", v))
+	default:
+		return newError("eos_extended_name.type", field, rule, "The %s field is not a known type for an EOS name")
+	}
+}
+
+// EOSAssetRule validates an EOS asset like `1.0000 EOS` or an `eos.Asset`.
+func EOSAssetRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkAsset := func(field string, asset string) error {
+		if !IsValidAsset(asset) {
+			return newError("eos_asset", field, rule, "The %s field must be a valid EOS asset")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkAsset(field, v)
+	case eos.Asset:
+		return checkAsset(field, v.String())
+	default:
+		return newError("eos_asset.type", field, rule, "The %s field is not a known type for an EOS asset")
+	}
+}
+
+// EOSAssetRuleFactory is like `EOSAssetRule` but also requires the asset to be
+// expressed in `requiredSymbol`, both its precision and code must match. A symbol
+// without code (i.e. `eos.Symbol{}`) skips that check, when only `options` matter.
+func EOSAssetRuleFactory(requiredSymbol eos.Symbol, options ...AssetOption) Rule {
+	config := assetOptions{}
+	for _, option := range options {
+		option(&config)
+	}
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := EOSAssetRule(field, rule, message, value)
+		if err != nil {
+			return err
+		}
+
+		var symbol eos.Symbol
+		var negative bool
+		switch v := value.(type) {
+		case string:
+			symbol, negative = assetSymbol(v), strings.HasPrefix(v, "-")
+		case eos.Asset:
+			symbol, negative = v.Symbol, v.Amount < 0
+		}
+
+		if config.nonNegative && negative {
+			return newError("eos_asset.negative", field, rule, "The %s field must be a non-negative amount")
+		}
+
+		if requiredSymbol.Symbol != "" && (symbol.Precision != requiredSymbol.Precision || symbol.Symbol != requiredSymbol.Symbol) {
+			return newError("eos_asset.symbol", field, rule, "The %s field must be an amount in %s", requiredSymbol.String())
+		}
+
+		return nil
+	}
+}
+
+// AssetOption configures the additional checks of `EOSAssetRuleFactory`.
+type AssetOption func(options *assetOptions)
+
+type assetOptions struct {
+	nonNegative bool
+}
+
+// AssetNonNegative rejects negative amounts, EOS assets can be negative but
+// user-facing amounts (deposits, transfers) rarely are.
+func AssetNonNegative() AssetOption {
+	return func(options *assetOptions) {
+		options.nonNegative = true
+	}
+}
+
+// EOSExtendedAssetRule validates an asset qualified by its contract like
+// `1.0000 EOS@eosio.token`, see `IsValidExtendedAsset`.
+func EOSExtendedAssetRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	var valid bool
+	switch v := value.(type) {
+	case string:
+		valid = IsValidExtendedAsset(v)
+	case eos.ExtendedAsset:
+		valid = v.Contract != "" && IsValidName(string(v.Contract)) && IsValidAsset(v.Asset.String())
+	default:
+		return newError("eos_extended_asset.type", field, rule, "The %s field is not a known type for an EOS extended asset")
+	}
+
+	if !valid {
+		return newError("eos_extended_asset", field, rule, "The %s field must be a valid extended asset")
+	}
+
+	return nil
+}
+
+// EOSSymbolRule validates an EOS symbol like `4,EOS` or an `eos.Symbol`.
+func EOSSymbolRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkSymbol := func(field string, symbol string) error {
+		if !IsValidSymbol(symbol) {
+			return newError("eos_symbol", field, rule, "The %s field must be a valid EOS symbol")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkSymbol(field, v)
+	case eos.Symbol:
+		return checkSymbol(field, v.String())
+	default:
+		return newError("eos_symbol.type", field, rule, "The %s field is not a known type for an EOS symbol")
+	}
+}
+
+// EOSSymbolRuleFactory is like `EOSSymbolRule` but also requires the symbol to have
+// exactly `requiredPrecision` and `requiredCode`, a negative precision or an empty
+// code skipping the respective check.
+func EOSSymbolRuleFactory(requiredPrecision int, requiredCode string) Rule {
+	var expected string
+	switch {
+	case requiredPrecision >= 0 && requiredCode != "":
+		expected = fmt.Sprintf("symbol %s with precision %d", requiredCode, requiredPrecision)
+	case requiredCode != "":
+		expected = fmt.Sprintf("symbol %s", requiredCode)
+	default:
+		expected = fmt.Sprintf("a symbol with precision %d", requiredPrecision)
+	}
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := EOSSymbolRule(field, rule, message, value)
+		if err != nil {
+			return err
+		}
+
+		symbol := value
+		if v, ok := value.(eos.Symbol); ok {
+			symbol = v.String()
+		}
+
+		rawPrecision, code, _ := cut(symbol.(string), ",")
+		precision, _ := strconv.Atoi(rawPrecision)
+
+		if (requiredPrecision >= 0 && precision != requiredPrecision) || (requiredCode != "" && code != requiredCode) {
+			return newError("eos_symbol.mismatch", field, rule, "The %s field must be %s", expected)
+		}
+
+		return nil
+	}
+}
+
+// EOSExtendedSymbolRule validates a symbol qualified by its contract like
+// `4,EOS@eosio.token`, see `IsValidExtendedSymbol`.
+func EOSExtendedSymbolRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	val, ok := value.(string)
+	if !ok {
+		return newError("eos_extended_symbol.type", field, rule, "The %s field must be a string")
+	}
+
+	if !IsValidExtendedSymbol(val) {
+		return newError("eos_extended_symbol", field, rule, "The %s field must be a valid extended symbol")
+	}
+
+	return nil
+}
+
+// EOSSymbolCodeRule validates an EOS symbol code like `EOS` or an `eos.SymbolCode`.
+func EOSSymbolCodeRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkSymbolCode := func(field string, symbolCode string) error {
+		if !IsValidSymbolCode(symbolCode) {
+			return newError("eos_symbol_code", field, rule, "The %s field must be a valid EOS symbol code")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkSymbolCode(field, v)
+	case eos.SymbolCode:
+		return checkSymbolCode(field, v.String())
+	default:
+		return newError("eos_symbol_code.type", field, rule, "The %s field is not a known type for an EOS symbol code")
+	}
+}
+
+// EOSPublicKeyRule validates an EOS public key, as a string or an `ecc.PublicKey`.
+func EOSPublicKeyRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkPublicKey := func(field string, publicKey string) error {
+		if !IsValidPublicKey(publicKey) {
+			return newError("eos_public_key", field, rule, "The %s field must be a valid EOS public key")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkPublicKey(field, v)
+	case ecc.PublicKey:
+		if len(v.Content) == 0 {
+			return newError("eos_public_key", field, rule, "The %s field must be a valid EOS public key")
+		}
+
+		return checkPublicKey(field, v.String())
+	default:
+		return newError("eos_public_key.type", field, rule, "The %s field is not a known type for an EOS public key")
+	}
+}
+
+// EOSSignatureRule validates an EOS signature, as a string or an `ecc.Signature`.
+func EOSSignatureRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkSignature := func(field string, signature string) error {
+		if _, err := ecc.NewSignature(signature); err != nil {
+			return newError("eos_signature", field, rule, "The %s field must be a valid EOS signature")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkSignature(field, v)
+	case ecc.Signature:
+		if len(v.Content) == 0 {
+			return newError("eos_signature", field, rule, "The %s field must be a valid EOS signature")
+		}
+
+		return checkSignature(field, v.String())
+	default:
+		return newError("eos_signature.type", field, rule, "The %s field is not a known type for an EOS signature")
+	}
+}
+
+// EOSSignatureSliceRule validates a non-empty array of EOS signatures.
+func EOSSignatureSliceRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, []string{})
+	if !present {
+		return nil
+	}
+
+	signatures, ok := value.([]string)
+	if !ok {
+		return newError("eos_signature_slice.type", field, rule, "The %s field must be a string array")
+	}
+
+	if len(signatures) <= 0 {
+		return newError("eos_signature_slice.min", field, rule, "The %s field must have at least 1 element")
+	}
+
+	return validateElements(field, rule, message, signatures, EOSSignatureRule)
+}
+
+// EOSPermissionLevelRule validates an `actor@permission` EOS permission level.
+func EOSPermissionLevelRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkPermissionLevel := func(field string, permissionLevel string) error {
+		if !IsValidPermissionLevel(permissionLevel) {
+			return newError("eos_permission_level", field, rule, "The %s field must be a valid EOS permission level")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkPermissionLevel(field, v)
+	case eos.PermissionLevel:
+		return checkPermissionLevel(field, fmt.Sprintf("%s@%s", v.Actor, v.Permission))
+	default:
+		return newError("eos_permission_level.type", field, rule, "The %s field is not a known type for an EOS permission level")
+	}
+}
+
+// EOSAuthorityRule validates an EOS authority, given as an `eos.Authority` or as its
+// JSON representation (raw bytes, string or decoded `map[string]interface{}`). The
+// threshold must be positive, every key and account must be valid and the sum of
+// all weights must be able to satisfy the threshold.
+func EOSAuthorityRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	var authority eos.Authority
+	switch v := value.(type) {
+	case eos.Authority:
+		authority = v
+	case string, []byte, map[string]interface{}:
+		if err := decodeJSONValue(v, &authority); err != nil {
+			return newError("eos_authority", field, rule, "The %s field must be a valid EOS authority")
+		}
+	default:
+		return newError("eos_authority.type", field, rule, "The %s field is not a known type for an EOS authority")
+	}
+
+	if authority.Threshold == 0 {
+		return newError("eos_authority.threshold", field, rule, "The %s field must be a valid EOS authority")
+	}
+
+	weights := uint64(0)
+	for i, key := range authority.Keys {
+		if err := EOSPublicKeyRule(fmt.Sprintf("%s.keys[%d]", field, i), rule, message, key.PublicKey); err != nil {
+			return err
+		}
+
+		weights += uint64(key.Weight)
+	}
+
+	for i, account := range authority.Accounts {
+		if err := EOSPermissionLevelRule(fmt.Sprintf("%s.accounts[%d]", field, i), rule, message, account.Permission); err != nil {
+			return err
+		}
+
+		weights += uint64(account.Weight)
+	}
+
+	for _, wait := range authority.Waits {
+		weights += uint64(wait.Weight)
+	}
+
+	if weights < uint64(authority.Threshold) {
+		return newError("eos_authority.weights", field, rule, "The %s field must be a valid EOS authority")
+	}
+
+	return nil
+}
+
+// EOSWeightRule validates the weight of an authority key, account or wait, an
+// integer between 1 and 65535.
+func EOSWeightRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	val, ok := integerString(value)
+	if !ok {
+		return newError("eos_weight.type", field, rule, "The %s field is not a known type for an authority weight")
+	}
+
+	weight, err := strconv.ParseUint(val, 10, 16)
+	if err != nil || weight == 0 {
+		return newError("eos_weight", field, rule, "The %s field must be a valid authority weight")
+	}
+
+	return nil
+}
+
+// EOSThresholdRule validates the threshold of an authority, an integer between 1
+// and 4294967295.
+func EOSThresholdRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	val, ok := integerString(value)
+	if !ok {
+		return newError("eos_threshold.type", field, rule, "The %s field is not a known type for an authority threshold")
+	}
+
+	threshold, err := strconv.ParseUint(val, 10, 32)
+	if err != nil || threshold == 0 {
+		return newError("eos_threshold", field, rule, "The %s field must be a valid authority threshold")
+	}
+
+	return nil
+}
+
+// EOSRAMBytesRule validates a RAM quantity, a byte count between 0 and the uint64
+// maximum.
+func EOSRAMBytesRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	val, ok := integerString(value)
+	if !ok {
+		return newError("eos_ram_bytes.type", field, rule, "The %s field is not a known type for a RAM byte count")
+	}
+
+	if _, err := strconv.ParseUint(val, 10, 64); err != nil {
+		return newError("eos_ram_bytes", field, rule, "The %s field must be a valid RAM byte count")
+	}
+
+	return nil
+}
+
+// EOSABIRule validates a contract ABI, given as an `eos.ABI` or as its JSON
+// representation (raw bytes or string), see `IsValidABI` for the checks performed.
+func EOSABIRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	var abi eos.ABI
+	switch v := value.(type) {
+	case eos.ABI:
+		abi = v
+	case string, []byte:
+		if err := decodeJSONValue(v, &abi); err != nil {
+			return newError("eos_abi", field, rule, "The %s field must be a valid EOS ABI")
+		}
+	default:
+		return newError("eos_abi.type", field, rule, "The %s field is not a known type for an EOS ABI")
+	}
+
+	if !IsValidABI(abi) {
+		return newError("eos_abi", field, rule, "The %s field must be a valid EOS ABI")
+	}
+
+	return nil
+}
+
+// EOSPackedTransactionRule validates a hex encoded packed transaction (raw bytes are
+// also accepted) by decoding it into an `eos.Transaction`.
+func EOSPackedTransactionRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	if err := validateHex(field, rule, value, -1); err != nil {
+		return err
+	}
+
+	data, _ := hex.DecodeString(hexString(value))
+
+	var tx eos.Transaction
+	if err := eos.NewDecoder(data).Decode(&tx); err != nil {
+		return newError("eos_packed_transaction", field, rule, "The %s field must be a valid packed transaction")
+	}
+
+	return nil
+}
+
+// eosMemoMaxBytes is the maximum size of a transfer memo enforced by `eosio.token`
+const eosMemoMaxBytes = 256
+
+// EOSMemoRule validates a transfer memo, which is limited to 256 bytes (not runes)
+// once UTF-8 encoded. Empty memos are valid.
+func EOSMemoRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	memo, ok := value.(string)
+	if !ok {
+		return newError("eos_memo.type", field, rule, "The %s field must be a string")
+	}
+
+	if len(memo) > eosMemoMaxBytes {
+		return newError("eos_memo", field, rule, "The %s field must be at most %d bytes", eosMemoMaxBytes)
+	}
+
+	return nil
+}
+
+// EOSScopeRule validates a table scope, either an EOS name or its raw uint64 value.
+func EOSScopeRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		if IsValidName(v) {
+			return nil
+		}
+	case eos.Name:
+		if !IsValidName(string(v)) {
+			return newError("eos_scope", field, rule, "The %s field must be a valid table scope")
+		}
+
+		return nil
+	}
+
+	val, ok := integerString(value)
+	if !ok {
+		return newError("eos_scope.type", field, rule, "The %s field is not a known type for a table scope")
+	}
+
+	if _, err := strconv.ParseUint(val, 10, 64); err != nil {
+		return newError("eos_scope", field, rule, "The %s field must be a valid table scope")
+	}
+
+	return nil
+}
+
+// EOSNamesListRuleFactory validates a `sep` separated list of EOS names.
+func EOSNamesListRuleFactory(sep string, maxCount int, options ...ListOption) Rule {
+	return ListRuleFactory(sep, maxCount, EOSNameRule, options...)
+}
+
+// EOSNamesListRuleFactoryUnique is like `EOSNamesListRuleFactory` but also rejects
+// lists containing the same name more than once.
+func EOSNamesListRuleFactoryUnique(sep string, maxCount int, options ...ListOption) Rule {
+	return listRuleFactory(sep, maxCount, EOSNameRule, validateUniqueElements, options)
+}
+
+// EOSExtendedNamesListRuleFactory validates a `sep` separated list of extended names.
+func EOSExtendedNamesListRuleFactory(sep string, maxCount int, options ...ListOption) Rule {
+	return ListRuleFactory(sep, maxCount, EOSExtendedNameRule, options...)
+}
+
+// EOSPublicKeyListRuleFactory validates a `sep` separated list of EOS public keys.
+func EOSPublicKeyListRuleFactory(sep string, maxCount int, options ...ListOption) Rule {
+	return ListRuleFactory(sep, maxCount, EOSPublicKeyRule, options...)
+}
+
+// EOSTrxIDListRuleFactory validates a `sep` separated list of EOS transaction ids.
+func EOSTrxIDListRuleFactory(sep string, maxCount int, options ...ListOption) Rule {
+	return ListRuleFactory(sep, maxCount, EOSTrxIDRule, options...)
+}
+
+// EOSAssetListRuleFactory validates a `sep` separated list of EOS assets. A
+// `[]eos.Asset` value is also accepted, its elements being valid by construction
+// only the element count is checked.
+func EOSAssetListRuleFactory(sep string, maxCount int, options ...ListOption) Rule {
+	listRule := ListRuleFactory(sep, maxCount, EOSAssetRule, options...)
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		assets, ok := value.([]eos.Asset)
+		if !ok {
+			return listRule(field, rule, message, value)
+		}
+
+		return checkElementCount(field, rule, len(assets), maxCount)
+	}
+}
+
+// EOSSymbolListRuleFactory validates a `sep` separated list of EOS symbols. A
+// `[]eos.Symbol` value is also accepted, each element being validated like a
+// typed `eos.Symbol` value.
+func EOSSymbolListRuleFactory(sep string, maxCount int, options ...ListOption) Rule {
+	listRule := ListRuleFactory(sep, maxCount, EOSSymbolRule, options...)
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		symbols, ok := value.([]eos.Symbol)
+		if !ok {
+			return listRule(field, rule, message, value)
+		}
+
+		if err := checkElementCount(field, rule, len(symbols), maxCount); err != nil {
+			return err
+		}
+
+		for i, symbol := range symbols {
+			if err := EOSSymbolRule(fmt.Sprintf("%s[%d]", field, i), rule, message, symbol); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// Deprecated: Use `ListRuleFactory` instead
+var StringListRuleFactory = ListRuleFactory
+
+// ListRuleFactory creates a rule that splits a string value on `sep` and validates
+// each element against `elementRule`, reporting errors with the element's index
+// (i.e. `The field[1] field ...`). Empty elements, like the one following a trailing
+// separator, are skipped before counting and validating unless `ListSkipEmpty(false)`
+// is given (see `ExplodeNames`).
+func ListRuleFactory(sep string, maxCount int, elementRule Rule, options ...ListOption) Rule {
+	return listRuleFactory(sep, maxCount, elementRule, validateElements, options)
+}
+
+// ListRuleFactoryAll is like `ListRuleFactory` but validates every element instead
+// of stopping at the first failure, all element errors are returned as
+// `ValidationErrors` keyed by element field (i.e. `names[2]`).
+func ListRuleFactoryAll(sep string, maxCount int, elementRule Rule, options ...ListOption) Rule {
+	return listRuleFactory(sep, maxCount, elementRule, validateAllElements, options)
+}
+
+// ListOption configures how the list rules split their value into elements.
+type ListOption func(options *listOptions)
+
+type listOptions struct {
+	trimSpace bool
+	skipEmpty bool
+}
+
+// ListTrimSpace trims the whitespace surrounding each element before validating
+// it, so `eos, eosio` is accepted as a `,` separated list.
+func ListTrimSpace() ListOption {
+	return func(options *listOptions) {
+		options.trimSpace = true
+	}
+}
+
+// ListSkipEmpty controls whether empty and whitespace only elements are dropped
+// before counting and validating, which is the default. With `skipEmpty` false,
+// `eos|eosio|` has 3 elements, the last one being validated as an empty value.
+func ListSkipEmpty(skipEmpty bool) ListOption {
+	return func(options *listOptions) {
+		options.skipEmpty = skipEmpty
+	}
+}
+
+type elementsValidator func(field string, rule string, message string, elements []string, elementRule Rule) error
+
+func listRuleFactory(sep string, maxCount int, elementRule Rule, validate elementsValidator, options []ListOption) Rule {
+	config := listOptions{skipEmpty: true}
+	for _, option := range options {
+		option(&config)
+	}
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		rawNames, ok := value.(string)
+		if !ok {
+			return newError("list.type", field, rule, "The %s field must be a string")
+		}
+
+		names := explodeList(rawNames, sep, config.skipEmpty)
+		if config.trimSpace {
+			for i, name := range names {
+				names[i] = strings.TrimSpace(name)
+			}
+		}
+
+		if err := checkElementCount(field, rule, len(names), maxCount); err != nil {
+			return err
+		}
+
+		return validate(field, rule, message, names, elementRule)
+	}
+}
+
+func checkElementCount(field string, rule string, count int, maxCount int) error {
+	if count <= 0 {
+		return newError("list.min", field, rule, "The %s field must have at least 1 element")
+	}
+
+	if count > maxCount {
+		return newError("list.max", field, rule, "The %s field must have at most %s", pluralize(maxCount, "element"))
+	}
+
+	return nil
+}
+
+func validateAllElements(field string, rule string, message string, elements []string, elementRule Rule) error {
+	errs := ValidationErrors{}
+	for i, element := range elements {
+		elementField := fmt.Sprintf("%s[%d]", field, i)
+		if err := elementRule(elementField, rule, message, element); err != nil {
+			errs[elementField] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func validateElements(field string, rule string, message string, elements []string, elementRule Rule) error {
+	for i, element := range elements {
+		err := elementRule(fmt.Sprintf("%s[%d]", field, i), rule, message, element)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateUniqueElements(field string, rule string, message string, elements []string, elementRule Rule) error {
+	if err := validateElements(field, rule, message, elements, elementRule); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(elements))
+	for _, element := range elements {
+		if seen[element] {
+			return newError("list.unique", field, rule, "The %s field must not contain duplicate elements (%q is duplicated)", element)
+		}
+
+		seen[element] = true
+	}
+
+	return nil
+}
+
+// EOSTrxIDRule validates a transaction id, 64 hexadecimal characters or 32 raw bytes
+// (`[]byte` or `eos.Checksum256`).
+func EOSTrxIDRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	if checksum, ok := value.(eos.Checksum256); ok {
+		value = []byte(checksum)
+	}
+
+	err := validateHex(field, rule, value, -1)
+	if err != nil {
+		return err
+	}
+
+	val := hexString(value)
+	if len(val) != 64 {
+		return newError("eos_trx_id.length", field, rule, "The %s field must have exactly 64 characters")
+	}
+
+	return nil
+}
+
+// EOSChecksum256Rule validates a 256-bit checksum, 64 hexadecimal characters.
+func EOSChecksum256Rule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkChecksum256 := func(field string, checksum string) error {
+		if !IsValidChecksum256(checksum) {
+			return newError("eos_checksum256", field, rule, "The %s field must be a valid 256-bit checksum")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkChecksum256(field, v)
+	case eos.Checksum256:
+		return checkChecksum256(field, hex.EncodeToString(v))
+	case []byte:
+		return checkChecksum256(field, hex.EncodeToString(v))
+	default:
+		return newError("eos_checksum256.type", field, rule, "The %s field is not a known type for a 256-bit checksum")
+	}
+}
+
+// EOSChainIDRule validates a chain id, the 256-bit digest identifying an EOSIO network
+// given as 64 hexadecimal characters.
+func EOSChainIDRule(field string, rule string, message string, value interface{}) error {
+	_, err := eosChainID(field, rule, value)
+	return err
+}
+
+// EOSChainIDRuleFactory is like `EOSChainIDRule` but also rejects any chain id not
+// part of `allowed`, compared case insensitively.
+func EOSChainIDRuleFactory(allowed ...string) Rule {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, chainID := range allowed {
+		allowedSet[strings.ToLower(chainID)] = true
+	}
+
+	return func(field string, rule string, message string, value interface{}) error {
+		chainID, err := eosChainID(field, rule, value)
+		if err != nil || chainID == "" {
+			return err
+		}
+
+		if !allowedSet[strings.ToLower(chainID)] {
+			return newError("eos_chain_id.allowed", field, rule, "The %s field is not a supported chain id")
+		}
+
+		return nil
+	}
+}
+
+// eosChainID validates a chain id and returns its hexadecimal representation, empty
+// when the value is absent.
+func eosChainID(field string, rule string, value interface{}) (string, error) {
+	value, present := deref(value, "")
+	if !present {
+		return "", nil
+	}
+
+	var chainID string
+	switch v := value.(type) {
+	case string:
+		chainID = v
+	case eos.Checksum256:
+		chainID = hex.EncodeToString(v)
+	case []byte:
+		chainID = hex.EncodeToString(v)
+	default:
+		return "", newError("eos_chain_id.type", field, rule, "The %s field is not a known type for a chain id")
+	}
+
+	if !IsValidChecksum256(chainID) {
+		return "", newError("eos_chain_id", field, rule, "The %s field must be a valid chain id")
+	}
+
+	return chainID, nil
+}
+
+// EOSBlockIDRule validates an EOS block id (see `ParseBlockID`).
+func EOSBlockIDRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	checkBlockID := func(field string, blockID string) error {
+		if _, err := ParseBlockID(blockID); err != nil {
+			return newError("eos_block_id", field, rule, "The %s field must be a valid EOS block id")
+		}
+
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return checkBlockID(field, v)
+	case eos.Checksum256:
+		return checkBlockID(field, hex.EncodeToString(v))
+	default:
+		return newError("eos_block_id.type", field, rule, "The %s field is not a known type for an EOS block id")
+	}
+}
+
+// EOSTimePointSecRule validates an EOS time point like `2020-01-01T00:00:00`.
+func EOSTimePointSecRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, "")
+	if !present {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		// Length is checked explicitly since `time.Parse` accepts fractional seconds even when the layout has none
+		if _, err := time.Parse(eosTimePointSecLayout, v); err != nil || len(v) != len(eosTimePointSecLayout) {
+			return newError("eos_time_point_sec", field, rule, "The %s field is not a valid EOS time point")
+		}
+
+		return nil
+	case eos.TimePointSec:
+		return nil
+	default:
+		return newError("eos_time_point_sec.type", field, rule, "The %s field is not a known type for an EOS time point")
+	}
+}
+
+// CursorRule validates an opaque cursor by decoding it with `opaque.FromOpaque`.
+func CursorRule(field string, rule string, message string, value interface{}) error {
+	_, err := decodeCursor(field, rule, value, 0)
+	return err
+}
+
+// CursorRuleFactory is like `CursorRule` but rejects cursors longer than `maxLen`
+// before decoding them.
+func CursorRuleFactory(maxLen int) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		_, err := decodeCursor(field, rule, value, maxLen)
+		return err
+	}
+}
+
+// CursorVersionRuleFactory is like `CursorRule` but also rejects cursors whose
+// version, see `CursorVersion`, is lower than `minVersion`.
+func CursorVersionRuleFactory(minVersion int) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		decoded, err := decodeCursor(field, rule, value, 0)
+		if err != nil || decoded == "" {
+			return err
+		}
+
+		if CursorVersion([]byte(decoded)) < minVersion {
+			return newError("cursor.version", field, rule, "The %s field cursor version is unsupported")
+		}
+
+		return nil
+	}
+}
+
+// decodeCursor validates a cursor and returns its decoded content, empty when the
+// cursor is absent. Its length is not bounded when `maxLen` is 0.
+func decodeCursor(field string, rule string, value interface{}, maxLen int) (string, error) {
+	value, present := deref(value, "")
+	if !present {
+		return "", nil
+	}
+
+	val, ok := value.(string)
+	if !ok {
+		return "", newError("cursor.type", field, rule, "The %s field must be a string")
+	}
+
+	if val == "" {
+		return "", nil
+	}
+
+	if maxLen > 0 && len(val) > maxLen {
+		return "", newError("cursor.length", field, rule, "The %s field cursor is too long")
+	}
+
+	decoded, err := opaque.FromOpaque(val)
+	if err != nil {
+		return "", newError("cursor", field, rule, "The %s field is not a valid cursor")
+	}
+
+	return decoded, nil
+}
+
+// Base64URLRule validates a base64url encoded string, the empty string included.
+func Base64URLRule(field string, rule string, message string, value interface{}) error {
+	return Base64URLRuleFactory(true)(field, rule, message, value)
+}
+
+// Base64URLRuleFactory creates a rule validating base64url encoded strings, the
+// empty string is accepted only when `allowEmpty` is true.
+func Base64URLRuleFactory(allowEmpty bool) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		val, ok := value.(string)
+		if !ok {
+			return newError("base64url.type", field, rule, "The %s field must be a string")
+		}
+
+		if val == "" && allowEmpty {
+			return nil
+		}
+
+		if val == "" || !IsValidBase64URL(val) {
+			return newError("base64url", field, rule, "The %s field must be valid base64url")
+		}
+
+		return nil
+	}
+}
+
+// Base58Rule validates a base58 encoded string, without checksum verification.
+func Base58Rule(field string, rule string, message string, value interface{}) error {
+	return Base58CheckRuleFactory(false)(field, rule, message, value)
+}
+
+// Base58CheckRuleFactory creates a rule validating base58 encoded strings, when
+// `checksum` is true, the decoded bytes must also end with a valid 4 bytes checksum.
+func Base58CheckRuleFactory(checksum bool) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		val, ok := value.(string)
+		if !ok {
+			return newError("base58.type", field, rule, "The %s field must be a string")
+		}
+
+		if !IsValidBase58(val) {
+			return newError("base58", field, rule, "The %s field must be valid base58")
+		}
+
+		if checksum && !IsValidBase58Check(val) {
+			return newError("base58.checksum", field, rule, "The %s field must have a valid base58 checksum")
+		}
+
+		return nil
 	}
+}
 
-	_, err := strconv.ParseInt(val, 10, 64)
-	if err != nil {
-		return fmt.Errorf("The %s field must be a valid EOS block num", field)
-	}
+// IntRangeRuleFactory creates a rule validating an integer, a decimal string or a
+// numeric value (see `EOSBlockNumRule`), between `min` and `max` inclusively.
+func IntRangeRuleFactory(min, max int64) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
 
-	return nil
-}
+		val, ok := integerString(value)
+		if !ok {
+			return newError("int_range.type", field, rule, "The %s field is not a known type for an integer")
+		}
 
-func EOSNameRule(field string, rule string, message string, value interface{}) error {
-	checkName := func(field string, name string) error {
-		if !IsValidName(name) {
-			return fmt.Errorf("The %s field must be a valid EOS name", field)
+		number, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return newError("int_range", field, rule, "The %s field must be between %d and %d", min, max)
+			}
+
+			return newError("int_range.integer", field, rule, "The %s field must be an integer")
+		}
+
+		if number < min || number > max {
+			return newError("int_range", field, rule, "The %s field must be between %d and %d", min, max)
 		}
 
 		return nil
 	}
+}
 
-	switch v := value.(type) {
-	case string:
-		return checkName(field, v)
-	case eos.Name, eos.PermissionName, eos.ActionName, eos.AccountName, eos.TableName:
-		return checkName(field, fmt.Sprintf("%s", v))
-	default:
-		return fmt.Errorf("The %s field is not a known type for an EOS name", field)
+// LengthRangeRuleFactory creates a rule validating that a string value is between
+// `min` and `max` characters long inclusively, counting runes and not bytes.
+func LengthRangeRuleFactory(min, max int) Rule {
+	return lengthRangeRuleFactory("length_range", min, max, utf8.RuneCountInString)
+}
+
+// ByteLengthRangeRuleFactory is like `LengthRangeRuleFactory` but counts bytes, for
+// fields with hard storage limits.
+func ByteLengthRangeRuleFactory(min, max int) Rule {
+	return lengthRangeRuleFactory("byte_length_range", min, max, func(value string) int { return len(value) })
+}
+
+// MinLengthRuleFactory creates a rule validating that a string value is at least
+// `n` characters long, counting runes.
+func MinLengthRuleFactory(n int) Rule {
+	return lengthRangeRuleFactory("min_length", n, math.MaxInt32, utf8.RuneCountInString)
+}
+
+// MaxLengthRuleFactory creates a rule validating that a string value is at most
+// `n` characters long, counting runes.
+func MaxLengthRuleFactory(n int) Rule {
+	return lengthRangeRuleFactory("max_length", 0, n, utf8.RuneCountInString)
+}
+
+// ExactLengthRuleFactory creates a rule validating that a string value is exactly
+// `n` characters long, counting runes.
+func ExactLengthRuleFactory(n int) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		val, ok := value.(string)
+		if !ok {
+			return newError("exact_length.type", field, rule, "The %s field must be a string")
+		}
+
+		if utf8.RuneCountInString(val) != n {
+			return newError("exact_length", field, rule, "The %s field must have exactly %s", pluralize(n, "character"))
+		}
+
+		return nil
 	}
 }
 
-func EOSExtendedNameRule(field string, rule string, message string, value interface{}) error {
-	checkName := func(field string, name string) error {
-		if !IsValidExtendedName(name) {
-			return fmt.Errorf("The %s field must be a valid EOS name", field)
+func lengthRangeRuleFactory(key string, min, max int, length func(value string) int) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		val, ok := value.(string)
+		if !ok {
+			return newError(key+".type", field, rule, "The %s field must be a string")
+		}
+
+		valLength := length(val)
+		if valLength < min {
+			return newError(key+".min", field, rule, "The %s field must be at least %s", pluralize(min, "character"))
+		}
+
+		if valLength > max {
+			return newError(key+".max", field, rule, "The %s field must be at most %s", pluralize(max, "character"))
 		}
 
 		return nil
 	}
+}
 
-	switch v := value.(type) {
-	case string:
-		return checkName(field, v)
-	case eos.Symbol:
-		return checkName(field, v.String())
-	case eos.SymbolCode:
-		return checkName(field, v.String())
-	case eos.Name, eos.PermissionName, eos.ActionName, eos.AccountName, eos.TableName:
-		return checkName(field, fmt.Sprintf("%s", v))
-	default:
-		return fmt.Errorf("The %s field is not a known type for an EOS name", field)
+// RegexRuleFactory creates a rule validating that the whole string value matches
+// `pattern`, compiled once here (panics if invalid). On mismatch, `mismatchMessage`
+// is reported with its `{field}` placeholder replaced by the field name.
+func RegexRuleFactory(pattern string, mismatchMessage string) Rule {
+	regex := regexp.MustCompile(`^(?:` + pattern + `)$`)
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		val, ok := value.(string)
+		if !ok {
+			return newError("regex.type", field, rule, "The %s field must be a string")
+		}
+
+		if !regex.MatchString(val) {
+			return newTemplateError("regex", field, rule, mismatchMessage)
+		}
+
+		return nil
 	}
 }
 
-func EOSNamesListRuleFactory(sep string, maxCount int) Rule {
-	return StringListRuleFactory(sep, maxCount, EOSNameRule)
+// OneOfRuleFactory creates a rule validating that the string value is exactly one
+// of `allowed` (case-sensitive).
+func OneOfRuleFactory(allowed ...string) Rule {
+	return oneOfRuleFactory(allowed, false)
 }
 
-func EOSExtendedNamesListRuleFactory(sep string, maxCount int) Rule {
-	return StringListRuleFactory(sep, maxCount, EOSExtendedNameRule)
+// OneOfFoldRuleFactory is like `OneOfRuleFactory` but compares case-insensitively.
+func OneOfFoldRuleFactory(allowed ...string) Rule {
+	return oneOfRuleFactory(allowed, true)
 }
 
-func StringListRuleFactory(sep string, maxCount int, elementRule Rule) Rule {
+func oneOfRuleFactory(allowed []string, foldCase bool) Rule {
+	allowed = append([]string(nil), allowed...)
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, element := range allowed {
+		if foldCase {
+			element = strings.ToLower(element)
+		}
+
+		allowedSet[element] = true
+	}
+
 	return func(field string, rule string, message string, value interface{}) error {
-		rawNames, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("The %s field must be a string", field)
+		value, present := deref(value, "")
+		if !present {
+			return nil
 		}
 
-		names := ExplodeNames(rawNames, sep)
-		nameCount := len(names)
-		if nameCount <= 0 {
-			return fmt.Errorf("The %s field must have at least 1 element", field)
+		val, ok := value.(string)
+		if !ok {
+			return newError("one_of.type", field, rule, "The %s field must be a string")
 		}
 
-		if nameCount > maxCount {
-			return fmt.Errorf("The %s field must have at most %d elements", field, maxCount)
+		if foldCase {
+			val = strings.ToLower(val)
 		}
 
-		for i, name := range names {
-			err := elementRule(fmt.Sprintf("%s[%d]", field, i), rule, message, name)
-			if err != nil {
-				return err
-			}
+		if !allowedSet[val] {
+			return newError("one_of", field, rule, "The %s field must be one of: %s", strings.Join(allowed, ", "))
 		}
 
 		return nil
 	}
 }
 
-func EOSTrxIDRule(field string, rule string, message string, value interface{}) error {
-	err := HexRowRule(field, rule, message, value)
-	if err != nil {
-		return err
+// DateTimeRuleFactory creates a rule accepting a date time string matching `layout`.
+func DateTimeRuleFactory(layout string) Rule {
+	return DateTimeMultiLayoutRuleFactory(layout)
+}
+
+// DateTimeMultiLayoutRuleFactory creates a rule accepting a date time string
+// matching any of the `layouts`, tried in order. Already parsed `time.Time`
+// values are always valid.
+func DateTimeMultiLayoutRuleFactory(layouts ...string) Rule {
+	layouts = append([]string(nil), layouts...)
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		var val string
+		switch v := value.(type) {
+		case string:
+			val = v
+		case time.Time:
+			return nil
+		default:
+			return newError("date_time.type", field, rule, "The %s field must be a string")
+		}
+
+		for _, layout := range layouts {
+			if _, err := time.Parse(layout, val); err == nil {
+				return nil
+			}
+		}
+
+		if len(layouts) == 1 {
+			return newError("date_time", field, rule, "The %s field is not a valid date time string according to layout %s", layouts[0])
+		}
+
+		return newError("date_time", field, rule, "The %s field is not a valid date time string according to layouts %s", strings.Join(layouts, ", "))
 	}
+}
 
-	val := value.(string)
-	if len(val) != 64 {
-		return fmt.Errorf("The %s field must have exactly 64 characters", field)
+// TimeRangeRuleFactory creates a rule validating a date time string according to
+// `layout` (same semantics as `DateTimeRuleFactory`) that must also be between
+// `min` and `max` inclusively.
+func TimeRangeRuleFactory(layout string, min, max time.Time) Rule {
+	dateTimeRule := DateTimeRuleFactory(layout)
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := dateTimeRule(field, rule, message, value)
+		if err != nil {
+			return err
+		}
+
+		val, ok := value.(time.Time)
+		if !ok {
+			val, _ = time.Parse(layout, value.(string))
+		}
+
+		if val.Before(min) || val.After(max) {
+			return newError("time_range", field, rule, "The %s field must be between %s and %s", min.Format(layout), max.Format(layout))
+		}
+
+		return nil
 	}
+}
 
-	return nil
+// DurationRule validates a duration string accepted by `time.ParseDuration` (i.e.
+// `30s`, `5m` or `24h`), `time.Duration` values are always valid.
+func DurationRule(field string, rule string, message string, value interface{}) error {
+	_, err := parseDuration(field, rule, value)
+	return err
 }
 
-func CursorRule(field string, rule string, message string, value interface{}) error {
-	val, ok := value.(string)
-	if !ok {
-		return fmt.Errorf("The %s field must be a string", field)
-	}
+// DurationRangeRuleFactory is like `DurationRule` but the duration must also be
+// between `min` and `max` inclusively.
+func DurationRangeRuleFactory(min, max time.Duration) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		duration, err := parseDuration(field, rule, value)
+		if err != nil {
+			return err
+		}
+
+		if duration < min || duration > max {
+			return newError("duration_range", field, rule, "The %s field must be between %s and %s", min, max)
+		}
 
-	if val == "" {
 		return nil
 	}
+}
 
-	_, err := opaque.FromOpaque(val)
-	if err != nil {
-		return fmt.Errorf("The %s field is not a valid cursor", field)
+func parseDuration(field string, rule string, value interface{}) (time.Duration, error) {
+	value, present := deref(value, "")
+	if !present {
+		return 0, nil
 	}
 
-	return nil
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, newError("duration", field, rule, "The %s field is not a valid duration")
+		}
+
+		return duration, nil
+	default:
+		return 0, newError("duration.type", field, rule, "The %s field is not a known type for a duration")
+	}
 }
 
-func DateTimeRuleFactory(layout string) Rule {
+// UnixTimestampRuleFactory creates a rule validating an integer unix timestamp
+// expressed in `unit` (i.e. `time.Second` or `time.Millisecond`), the value must
+// be positive and not after year 9999.
+func UnixTimestampRuleFactory(unit time.Duration) Rule {
+	maxValue := float64(maxUnixTimestamp) * float64(time.Second) / float64(unit)
+
 	return func(field string, rule string, message string, value interface{}) error {
-		val, ok := value.(string)
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		val, ok := integerString(value)
 		if !ok {
-			return fmt.Errorf("The %s field must be a string", field)
+			return newError("unix_timestamp.type", field, rule, "The %s field is not a known type for a unix timestamp")
 		}
 
-		_, err := time.Parse(layout, val)
-		if err != nil {
-			return fmt.Errorf("The %s field is not a valid date time string according to layout %s", field, layout)
+		timestamp, err := strconv.ParseInt(val, 10, 64)
+		if err != nil || timestamp < 0 || float64(timestamp) > maxValue {
+			return newError("unix_timestamp", field, rule, "The %s field is not a valid unix timestamp")
 		}
 
 		return nil
@@ -155,43 +2022,257 @@ func DateTimeRuleFactory(layout string) Rule {
 // Deprecated: Use `HexRule` instead
 var HexRowRule = HexRule
 
+// HexRule validates an hexadecimal string with an even number of characters, raw
+// `[]byte` values are always valid. A length parameter can be given after the `hex`
+// rule name (i.e. `hex:64` from a `validate:"hex:64"` tag), the value must then also
+// be exactly that many characters long, twice its bytes length. Parameters of other
+// rules delegating to this one (i.e. `hex_slice:3`) are ignored.
 func HexRule(field string, rule string, message string, value interface{}) error {
-	hexRow, ok := value.(string)
-	if !ok {
-		return fmt.Errorf("The %s field must be a string", field)
+	value, present := deref(value, "")
+	if !present {
+		return nil
 	}
 
-	match, _ := regexp.MatchString("^[A-Fa-f0-9]+$", hexRow)
-	if !match {
-		return fmt.Errorf("The %s field must be a valid hexadecimal", field)
+	length := -1
+	if name, param, found := cut(rule, ":"); found && name == "hex" {
+		var err error
+		if length, err = strconv.Atoi(param); err != nil || length < 0 {
+			return newError("hex.param", field, rule, "The %s field has an invalid hex length parameter")
+		}
+	}
+
+	return validateHex(field, rule, value, length)
+}
+
+// validateHex checks that value is an hexadecimal string or raw bytes, of exactly
+// `length` characters unless `length` is negative.
+func validateHex(field string, rule string, value interface{}, length int) error {
+	var hexRow string
+	switch v := value.(type) {
+	case []byte:
+		hexRow = hex.EncodeToString(v)
+	case string:
+		hexRow = v
+
+		match, _ := regexp.MatchString("^[A-Fa-f0-9]+$", hexRow)
+		if !match {
+			return newError("hex", field, rule, "The %s field must be a valid hexadecimal")
+		}
+
+		if len(hexRow)%2 != 0 {
+			return newError("hex.length", field, rule, "The %s field must have an even number of characters")
+		}
+	default:
+		return newError("hex.type", field, rule, "The %s field must be a string")
 	}
 
-	if len(hexRow)%2 != 0 {
-		return fmt.Errorf("The %s field must be a valid hexadecimal", field)
+	if length >= 0 && len(hexRow) != length {
+		return newError("hex_exact_length", field, rule, "The %s field must have exactly %d characters", length)
 	}
 
 	return nil
 }
 
+// HexRuleFactory is like `HexRule` but when `allowPrefix` is true, an optional
+// `0x` prefix is stripped before validating the hexadecimal characters.
+func HexRuleFactory(allowPrefix bool) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		if hexRow, ok := value.(string); ok && allowPrefix {
+			value = strings.TrimPrefix(hexRow, "0x")
+		}
+
+		return HexRule(field, rule, message, value)
+	}
+}
+
+// HexExactLengthRuleFactory validates an hexadecimal string of exactly `byteLen` bytes.
+func HexExactLengthRuleFactory(byteLen int) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, "")
+		if !present {
+			return nil
+		}
+
+		err := validateHex(field, rule, value, -1)
+		if err != nil {
+			return err
+		}
+
+		val := hexString(value)
+		if len(val) != byteLen*2 {
+			return newError("hex_exact_length", field, rule, "The %s field must have exactly %d characters", byteLen*2)
+		}
+
+		return nil
+	}
+}
+
 // Deprecated: Use `HexRowsRule` instead
 var HexRowsRule = HexSliceRule
 
+// HexSliceRule validates a non-empty array of hexadecimal strings or raw bytes.
 func HexSliceRule(field string, rule string, message string, value interface{}) error {
+	value, present := deref(value, []string{})
+	if !present {
+		return nil
+	}
+
+	if byteRows, ok := value.([][]byte); ok {
+		if len(byteRows) <= 0 {
+			return newError("hex_slice.min", field, rule, "The %s field must have at least 1 element")
+		}
+
+		return nil
+	}
+
 	hexRows, ok := value.([]string)
 	if !ok {
-		return fmt.Errorf("The %s field must be a string array", field)
+		return newError("hex_slice.type", field, rule, "The %s field must be a string array")
 	}
 
 	if len(hexRows) <= 0 {
-		return fmt.Errorf("The %s field must have at least 1 element", field)
+		return newError("hex_slice.min", field, rule, "The %s field must have at least 1 element")
+	}
+
+	return validateElements(field, rule, message, hexRows, HexRule)
+}
+
+// HexSliceRuleFactory is like `HexSliceRule` but allows at most `maxCount` elements.
+func HexSliceRuleFactory(maxCount int) Rule {
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, []string{})
+		if !present {
+			return nil
+		}
+
+		if rowCount(value) > maxCount {
+			return newError("hex_slice.max", field, rule, "The %s field must have at most %s", pluralize(maxCount, "element"))
+		}
+
+		return HexSliceRule(field, rule, message, value)
 	}
+}
+
+// HexSliceExactLengthRuleFactory is like `HexSliceRuleFactory` but also requires
+// each row to be exactly `byteLenPerRow` bytes long, i.e. `byteLenPerRow*2`
+// hexadecimal characters.
+func HexSliceExactLengthRuleFactory(maxCount int, byteLenPerRow int) Rule {
+	sliceRule := HexSliceRuleFactory(maxCount)
+	rowRule := HexExactLengthRuleFactory(byteLenPerRow)
 
-	for i, hexData := range hexRows {
-		err := HexRowRule(fmt.Sprintf("%s[%d]", field, i), rule, message, hexData)
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, []string{})
+		if !present {
+			return nil
+		}
+
+		err := sliceRule(field, rule, message, value)
 		if err != nil {
 			return err
 		}
+
+		switch v := value.(type) {
+		case []string:
+			return validateElements(field, rule, message, v, rowRule)
+		case [][]byte:
+			for i, row := range v {
+				if err := rowRule(fmt.Sprintf("%s[%d]", field, i), rule, message, row); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
 	}
+}
 
-	return nil
+// HexSliceMaxBytesRuleFactory is like `HexSliceRuleFactory` but also caps the
+// combined decoded length of all rows to `maxTotalBytes`, guarding against many
+// large rows adding up to an oversized payload.
+func HexSliceMaxBytesRuleFactory(maxCount int, maxTotalBytes int) Rule {
+	sliceRule := HexSliceRuleFactory(maxCount)
+
+	return func(field string, rule string, message string, value interface{}) error {
+		value, present := deref(value, []string{})
+		if !present {
+			return nil
+		}
+
+		err := sliceRule(field, rule, message, value)
+		if err != nil {
+			return err
+		}
+
+		totalBytes := 0
+		switch v := value.(type) {
+		case []string:
+			for _, row := range v {
+				totalBytes += len(row) / 2
+			}
+		case [][]byte:
+			for _, row := range v {
+				totalBytes += len(row)
+			}
+		}
+
+		if totalBytes > maxTotalBytes {
+			return newError("hex_slice.total_bytes", field, rule, "The %s field exceeds %d total bytes", maxTotalBytes)
+		}
+
+		return nil
+	}
+}
+
+// hexString returns the hexadecimal representation of a value already validated
+// by `HexRule`, raw bytes are hex encoded while strings are returned as-is.
+func hexString(value interface{}) string {
+	if raw, ok := value.([]byte); ok {
+		return hex.EncodeToString(raw)
+	}
+
+	return value.(string)
+}
+
+func rowCount(value interface{}) int {
+	switch v := value.(type) {
+	case []string:
+		return len(v)
+	case [][]byte:
+		return len(v)
+	default:
+		return 0
+	}
+}
+
+// decodeJSONValue decodes `value` into `out`, `value` being either raw JSON (string
+// or bytes) or an already decoded JSON object that is re-encoded first.
+func decodeJSONValue(value interface{}, out interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return err
+		}
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// pluralize returns `count` followed by `noun`, with an `s` suffix unless `count` is 1.
+func pluralize(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, noun)
+	}
+
+	return fmt.Sprintf("%d %ss", count, noun)
 }
@@ -1,13 +1,21 @@
 package validator
 
 import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/dfuse-io/opaque"
 	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ecc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type ruleTestCase struct {
@@ -23,165 +31,2012 @@ func TestEOSBlockNumRule(t *testing.T) {
 	}
 
 	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS block num"},
+		{"should be a known type", true, "The test field is not a known type for an EOS block num"},
+		{"should not contains invalid characters", "!", "The test field must be a valid EOS block num"},
+		{"should not overflow uint32", "4294967296", "The test field must be a valid EOS block num"},
+		{"should not overflow uint32 by far", "99999999999999", "The test field must be a valid EOS block num"},
+		{"should not be negative", "-1", "The test field must be a valid EOS block num"},
+		{"should not have a plus sign", "+10", "The test field must be a valid EOS block num"},
+		{"should not have leading zeros", "007", "The test field must be a valid EOS block num"},
+		{"should not be a negative int", -1, "The test field must be a valid EOS block num"},
+		{"should not overflow int64", int64(4294967296), "The test field must be a valid EOS block num"},
+		{"should not be a non-integral float64", 10.5, "The test field must be a valid EOS block num"},
+		{"should not be a non-integral json.Number", json.Number("10.5"), "The test field must be a valid EOS block num"},
+
+		{"valid block num", "10", ""},
+		{"valid zero", "0", ""},
+		{"valid max uint32", "4294967295", ""},
+		{"valid int", 10, ""},
+		{"valid int64", int64(10), ""},
+		{"valid uint32", uint32(4294967295), ""},
+		{"valid integral float64", 10.0, ""},
+		{"valid json.Number", json.Number("10"), ""},
+		{"valid integral json.Number", json.Number("10.0"), ""},
+		{"valid exponent json.Number", json.Number("1e3"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSRelativeBlockRule(t *testing.T) {
+	tag := "eos_relative_block"
+	validator := func(field string, value interface{}) error {
+		return EOSRelativeBlockRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid block reference"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be empty", "", "The test field must be a valid block reference"},
+		{"should be a known reference", "tail-10", "The test field must be a valid block reference"},
+		{"should have an offset", "head-", "The test field must be a valid block reference"},
+		{"should have a positive offset", "head--10", "The test field must be a valid block reference"},
+		{"should have a numeric offset", "LIB-a", "The test field must be a valid block reference"},
+		{"should not have leading zeros offset", "LIB-010", "The test field must be a valid block reference"},
+		{"should not be negative", "-10", "The test field must be a valid block reference"},
+
+		{"valid block num", "10", ""},
+		{"valid head", "head", ""},
+		{"valid head offset", "head-100", ""},
+		{"valid head zero offset", "head-0", ""},
+		{"valid LIB", "LIB", ""},
+		{"valid LIB offset", "LIB-10", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSBlockRangeRule(t *testing.T) {
+	tag := "eos_block_range"
+	validator := func(field string, value interface{}) error {
+		return EOSBlockRangeRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid block range"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should have two ends", "1000", "The test field must be a valid block range"},
+		{"should not have three ends", "1-2-3", "The test field must be a valid block range"},
+		{"should have a low end", "-2000", "The test field must be a valid block range"},
+		{"should have valid block nums", "1000-abc", "The test field must be a valid block range"},
+		{"should be ordered", "2000-1000", "The test field range is invalid"},
+
+		{"valid", "1000-2000", ""},
+		{"valid single block", "1000-1000", ""},
+		{"valid whole chain", "0-4294967295", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSBlockRangeRuleFactory(t *testing.T) {
+	tag := "eos_block_range"
+	rule := EOSBlockRangeRuleFactory(1000)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be ordered", "2000-1000", "The test field range is invalid"},
+		{"should not exceed max span", "1000-2000", "The test field range exceeds 1000 blocks"},
+		{"should not exceed max span whole chain", "0-4294967295", "The test field range exceeds 1000 blocks"},
+
+		{"valid max span", "1000-1999", ""},
+		{"valid single block", "1000-1000", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSBlockNumRuleFactory(t *testing.T) {
+	tag := "eos_block_num_keywords"
+	rule := EOSBlockNumRuleFactory(true)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	headKeyword := "head"
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS block num"},
+		{"should be a known type", true, "The test field is not a known type for an EOS block num"},
+		{"should not contains invalid characters", "!", "The test field must be a valid EOS block num"},
+		{"should not accept unknown keyword", "tail", "The test field must be a valid EOS block num"},
+		{"should be case sensitive", "HEAD", "The test field must be a valid EOS block num"},
+
+		{"valid block num", "10", ""},
+		{"valid head", "head", ""},
+		{"valid LIB", "LIB", ""},
+		{"valid last_irreversible", "last_irreversible", ""},
+		{"valid *string keyword", &headKeyword, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	strictRule := EOSBlockNumRuleFactory(false)
+	strictValidator := func(field string, value interface{}) error {
+		return strictRule(field, tag, "", value)
+	}
+
+	strictTests := []ruleTestCase{
+		{"should not accept head", "head", "The test field must be a valid EOS block num"},
+		{"should not accept LIB", "LIB", "The test field must be a valid EOS block num"},
+
+		{"valid block num", "10", ""},
+	}
+
+	runRuleTestCases(t, tag+"_strict", strictTests, strictValidator)
+}
+
+func TestEOSVarUint32Rule(t *testing.T) {
+	tag := "eos_varuint32"
+	validator := func(field string, value interface{}) error {
+		return EOSVarUint32Rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid varuint32"},
+		{"should be a known type", true, "The test field is not a known type for a varuint32"},
+		{"should not be empty", "", "The test field must be a valid varuint32"},
+		{"should not be negative", "-1", "The test field must be a valid varuint32"},
+		{"should not overflow", "4294967296", "The test field must be a valid varuint32"},
+		{"should not be a float", "1.5", "The test field must be a valid varuint32"},
+		{"should not overflow int64", int64(4294967296), "The test field must be a valid varuint32"},
+		{"should not be a negative int", -1, "The test field must be a valid varuint32"},
+		{"should not overflow json.Number", json.Number("4294967296"), "The test field must be a valid varuint32"},
+
+		{"valid zero", "0", ""},
+		{"valid max", "4294967295", ""},
+		{"valid int", 10, ""},
+		{"valid int64", int64(4294967295), ""},
+		{"valid uint64", uint64(4294967295), ""},
+		{"valid json.Number", json.Number("42"), ""},
+		{"valid eos.Varuint32", eos.Varuint32(42), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSVarInt32Rule(t *testing.T) {
+	tag := "eos_varint32"
+	validator := func(field string, value interface{}) error {
+		return EOSVarInt32Rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid varint32"},
+		{"should be a known type", true, "The test field is not a known type for a varint32"},
+		{"should not be empty", "", "The test field must be a valid varint32"},
+		{"should not overflow", "2147483648", "The test field must be a valid varint32"},
+		{"should not underflow", "-2147483649", "The test field must be a valid varint32"},
+		{"should not overflow int64", int64(2147483648), "The test field must be a valid varint32"},
+		{"should not underflow int", -2147483649, "The test field must be a valid varint32"},
+
+		{"valid min", "-2147483648", ""},
+		{"valid max", "2147483647", ""},
+		{"valid int", -10, ""},
+		{"valid json.Number", json.Number("-42"), ""},
+		{"valid eos.Varint32", eos.Varint32(-42), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSBlockNumRangeRule(t *testing.T) {
+	tag := "eos_block_num_range"
+	rule := EOSBlockNumRangeRuleFactory(2, 100)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS block num"},
+		{"should be a known type", true, "The test field is not a known type for an EOS block num"},
+		{"should not contains invalid characters", "!", "The test field must be a valid EOS block num"},
+		{"should not be lower than min", "1", "The test field must be between 2 and 100"},
+		{"should not be negative", "-1", "The test field must be a valid EOS block num"},
+		{"should not be greater than max", "101", "The test field must be between 2 and 100"},
+
+		{"valid min", "2", ""},
+		{"valid max", "100", ""},
+		{"valid in range", "50", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNameRule(t *testing.T) {
+	tag := "eos_name"
+	validator := func(field string, value interface{}) error {
+		return EOSNameRule(field, tag, "", value)
+	}
+
+	validName := "eosio"
+	validEOSName := eos.Name("eosio")
+	invalidName := "6"
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field is not a known type for an EOS name"},
+		{"should not contains invalid characters", "6", "The test field must be a valid EOS name"},
+		{"should not contains invalid characters *string", &invalidName, "The test field must be a valid EOS name"},
+		{"should not be longer than 13", "abcdefghigklma", "The test field must be a valid EOS name"},
+		{"should not have 13th character out of range", "abcdefghijklz", "The test field must be a valid EOS name"},
+		{"should not have 13th character just out of range", "eosio.tokenfk", "The test field must be a valid EOS name"},
+		{"should not have symbol precision above 18", "19,EOS", "The test field must be a valid EOS name"},
+		{"should not have oversized symbol precision", "99,EOS", "The test field must be a valid EOS name"},
+
+		{"valid empty", "", ""},
+		{"valid single", "e", ""},
+		{"valid limit", "5", ""},
+		{"valid with dots and 13 chars", "eosio.tokenfj", ""},
+		{"valid with 13th char dot", "eosio.tokenf.", ""},
+		{"valid with 13th char digit", "eosio.tokenf5", ""},
+		{"valid *string", &validName, ""},
+		{"valid nil *string", (*string)(nil), ""},
+		{"valid *eos.Name", &validEOSName, ""},
+		{"valid nil *eos.Name", (*eos.Name)(nil), ""},
+		{"valid eos.Name", eos.Name("eosio"), ""},
+		{"valid eos.PermissionName", eos.PermissionName("eosio"), ""},
+		{"valid eos.ActionName", eos.ActionName("eosio"), ""},
+		{"valid eos.AccountName", eos.AccountName("eosio"), ""},
+		{"valid eos.TableName", eos.TableName("eosio"), ""},
+		{"valid nil", nil, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func BenchmarkEOSNameRule(b *testing.B) {
+	names := []interface{}{"eosio", "eosio.token", "eosio.tokenfj", eos.AccountName("b1")}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := EOSNameRule("test", "eos_name", "", names[i%len(names)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestEOSActionNameRule(t *testing.T) {
+	tag := "eos_action_name"
+	validator := func(field string, value interface{}) error {
+		return EOSActionNameRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS action name"},
+		{"should be a known type", true, "The test field is not a known type for an EOS action name"},
+		{"should not be an account name", eos.AccountName("transfer"), "The test field is not a known type for an EOS action name"},
+		{"should not be empty", "", "The test field must be a valid EOS action name"},
+		{"should not contain invalid characters", "Transfer", "The test field must be a valid EOS action name"},
+		{"should not be longer than 13 characters", "transferfunds1", "The test field must be a valid EOS action name"},
+
+		{"valid", "transfer", ""},
+		{"valid eos.ActionName", eos.ActionName("newaccount"), ""},
+		{"valid eos.Name", eos.Name("setabi"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSContractActionRule(t *testing.T) {
+	tag := "eos_contract_action"
+	validator := func(field string, value interface{}) error {
+		return EOSContractActionRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid contract:action"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should have an action", "eosio.token", "The test field must be a valid contract:action"},
+		{"should have a contract", ":transfer", "The test field must be a valid contract:action"},
+		{"should have a non-empty action", "eosio.token:", "The test field must be a valid contract:action"},
+		{"should have a single separator", "eosio.token:transfer:extra", "The test field must be a valid contract:action"},
+		{"should have a valid contract", "Eosio.token:transfer", "The test field must be a valid contract:action"},
+		{"should have a valid action", "eosio.token:Transfer", "The test field must be a valid contract:action"},
+
+		{"valid", "eosio.token:transfer", ""},
+		{"valid dotted action", "eosio:set.code", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNameRuleFactory(t *testing.T) {
+	tag := "eos_name_no_edge_dots"
+	rule := EOSNameRuleFactory(false)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field is not a known type for an EOS name"},
+		{"should not contains invalid characters", "6", "The test field must be a valid EOS name"},
+		{"should not have a leading dot", ".eosio", "The test field must be a valid EOS name"},
+		{"should not have a trailing dot", "eosio.", "The test field must be a valid EOS name"},
+		{"should not have consecutive dots", "eos..io", "The test field must be a valid EOS name"},
+		{"should not have edge dots eos.AccountName", eos.AccountName("eosio."), "The test field must be a valid EOS name"},
+
+		{"valid empty", "", ""},
+		{"valid", "eosio", ""},
+		{"valid with inner dot", "eosio.token", ""},
+		{"valid eos.AccountName", eos.AccountName("eosio.token"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	permissiveRule := EOSNameRuleFactory(true)
+	permissiveValidator := func(field string, value interface{}) error {
+		return permissiveRule(field, tag, "", value)
+	}
+
+	permissiveTests := []ruleTestCase{
+		{"should not contains invalid characters", "6", "The test field must be a valid EOS name"},
+
+		{"valid leading dot", ".eosio", ""},
+		{"valid trailing dot", "eosio.", ""},
+		{"valid consecutive dots", "eos..io", ""},
+	}
+
+	runRuleTestCases(t, tag+"_permissive", permissiveTests, permissiveValidator)
+}
+
+func TestEOSAccountCreatableRule(t *testing.T) {
+	tag := "eos_account_creatable"
+	validator := func(field string, value interface{}) error {
+		return EOSAccountCreatableRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field is not a creatable account name"},
+		{"should be a known type", true, "The test field is not a known type for an EOS account name"},
+		{"should not be shorter than 12", "alice", "The test field is not a creatable account name"},
+		{"should not be longer than 12", "alicealice123", "The test field is not a creatable account name"},
+		{"should not contain dots", "alice.alice1", "The test field is not a creatable account name"},
+		{"should not contain invalid digits", "alicealice16", "The test field is not a creatable account name"},
+		{"should not contain uppercase", "Alicealice12", "The test field is not a creatable account name"},
+
+		{"valid", "alicealice12", ""},
+		{"valid eos.AccountName", eos.AccountName("bobbobbob345"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNameAllowlistRuleFactory(t *testing.T) {
+	tag := "eos_name_allowlist"
+	rule := EOSNameAllowlistRuleFactory("eosio.token", "eosio")
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a valid name", "6", "The test field must be a valid EOS name"},
+		{"should be allowed", "account", "The test field account is not permitted"},
+		{"should be allowed eos.AccountName", eos.AccountName("account"), "The test field account is not permitted"},
+		{"should be allowed any name", "alice", "The test field account is not permitted"},
+
+		{"valid allowed", "eosio.token", ""},
+		{"valid allowed eos.AccountName", eos.AccountName("eosio"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNameDenylistRuleFactory(t *testing.T) {
+	tag := "eos_name_denylist"
+	rule := EOSNameDenylistRuleFactory("eosio.token", "eosio")
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a valid name", "6", "The test field must be a valid EOS name"},
+		{"should not be denied", "eosio", "The test field account is not permitted"},
+		{"should not be denied eos.AccountName", eos.AccountName("eosio.token"), "The test field account is not permitted"},
+
+		{"valid not denied", "account", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+	assert.True(t, errors.Is(validator("test", "eosio"), ErrNotPermitted))
+}
+
+func TestEOSNameSuffixRuleFactory(t *testing.T) {
+	tag := "eos_name_suffix"
+	rule := EOSNameSuffixRuleFactory("myapp")
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a valid name", "6.myapp", "The test field must be a valid EOS name"},
+		{"should not be the suffix itself", "myapp", "The test field must be a sub-account of myapp"},
+		{"should not be the dotted suffix", ".myapp", "The test field must be a sub-account of myapp"},
+		{"should end with the dotted suffix", "alicemyapp", "The test field must be a sub-account of myapp"},
+		{"should end with the suffix", "alice.other", "The test field must be a sub-account of myapp"},
+
+		{"valid sub-account", "alice.myapp", ""},
+		{"valid sub-account eos.AccountName", eos.AccountName("bob.myapp"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNamePrefixRuleFactory(t *testing.T) {
+	tag := "eos_name_prefix"
+	rule := EOSNamePrefixRuleFactory("myapp")
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a valid name", "myapp.6", "The test field must be a valid EOS name"},
+		{"should not be the prefix itself", "myapp", "The test field must start with myapp."},
+		{"should start with the dotted prefix", "myappalice", "The test field must start with myapp."},
+		{"should start with the prefix", "other.alice", "The test field must start with myapp."},
+
+		{"valid prefixed", "myapp.alice", ""},
+		{"valid prefixed eos.AccountName", eos.AccountName("myapp.bob"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNameOrBlockNumRule(t *testing.T) {
+	tag := "eos_name_or_block_num"
+	validator := func(field string, value interface{}) error {
+		return EOSNameOrBlockNumRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a known type", true, "The test field is not a known type for an EOS name or block num"},
+		{"should be a name or a block num", "EOS-1", "The test field must be a valid EOS name or block num"},
+		{"should not overflow block num", "99999999999", "The test field must be a valid EOS name or block num"},
+		{"should be a valid eos.AccountName", eos.AccountName("EOS"), "The test field must be a valid EOS name or block num"},
+		{"should not be a negative int", -1, "The test field must be a valid EOS name or block num"},
+		{"should not be a fractional json.Number", json.Number("1.5"), "The test field must be a valid EOS name or block num"},
+
+		{"valid nil", nil, ""},
+		{"valid name", "eosio", ""},
+		{"valid block num", "67890", ""},
+		{"valid block num also a name", "12345", ""},
+		{"valid eos.AccountName", eos.AccountName("eosio"), ""},
+		{"valid int", 67890, ""},
+		{"valid json.Number", json.Number("67890"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSExtendedNameRule(t *testing.T) {
+	tag := "eos_extended_name"
+	validator := func(field string, value interface{}) error {
+		return EOSExtendedNameRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field is not a known type for an EOS name"},
+		{"should not contains invalid characters", "6", "The test field must be a valid EOS name"},
+		{"should not be longer than 13", "abcdefghigklma", "The test field must be a valid EOS name"},
+		{"should not have 13th character out of range", "abcdefghijklz", "The test field must be a valid EOS name"},
+		{"should not have 13th character just out of range", "eosio.tokenfk", "The test field must be a valid EOS name"},
+
+		{"valid empty", "", ""},
+		{"valid single", "e", ""},
+		{"valid limit", "5", ""},
+		{"valid with dots and 13 chars", "eosio.tokenfj", ""},
+		{"valid with 13th char dot", "eosio.tokenf.", ""},
+		{"valid with 13th char digit", "eosio.tokenf5", ""},
+		{"valid with whem symbol", "4,EOS", ""},
+		{"valid with whem symbol max precision", "18,EOS", ""},
+		{"valid with whem symbol code", "EOS", ""},
+
+		{"valid eos.Name", eos.Name("eosio"), ""},
+		{"valid eos.PermissionName", eos.PermissionName("eosio"), ""},
+		{"valid eos.ActionName", eos.ActionName("eosio"), ""},
+		{"valid eos.AccountName", eos.AccountName("eosio"), ""},
+		{"valid eos.TableName", eos.TableName("eosio"), ""},
+		{"valid nil", nil, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSAssetRule(t *testing.T) {
+	tag := "eos_asset"
+	validator := func(field string, value interface{}) error {
+		return EOSAssetRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS asset"},
+		{"should be a string", true, "The test field is not a known type for an EOS asset"},
+		{"should not be empty", "", "The test field must be a valid EOS asset"},
+		{"should have a symbol", "1.0000", "The test field must be a valid EOS asset"},
+		{"should have an amount", "EOS", "The test field must be a valid EOS asset"},
+		{"should have a single space", "1.0000  EOS", "The test field must be a valid EOS asset"},
+		{"should have an uppercase symbol", "1.0000 eos", "The test field must be a valid EOS asset"},
+		{"should not have a symbol longer than 7", "1.0000 ABCDEFGH", "The test field must be a valid EOS asset"},
+		{"should not have a dangling dot", "1. EOS", "The test field must be a valid EOS asset"},
+		{"should match declared precision", "1.0 4,EOS", "The test field must be a valid EOS asset"},
+		{"should match declared precision, fewer decimals", "1.00 4,EOS", "The test field must be a valid EOS asset"},
+		{"should not have precision over 18", "1.0000000000000000000 19,EOS", "The test field must be a valid EOS asset"},
+
+		{"valid", "1.0000 EOS", ""},
+		{"valid no decimals", "10 EOS", ""},
+		{"valid negative", "-1.0000 EOS", ""},
+		{"valid with declared precision", "1.0000 4,EOS", ""},
+		{"valid with zero precision", "1 0,EOS", ""},
+		{"valid eos.Asset", eos.Asset{Amount: 10000, Symbol: eos.Symbol{Precision: 4, Symbol: "EOS"}}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSAssetRuleFactory(t *testing.T) {
+	tag := "eos_asset_symbol"
+	rule := EOSAssetRuleFactory(eos.Symbol{Precision: 4, Symbol: "EOS"})
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a valid asset", "EOS", "The test field must be a valid EOS asset"},
+		{"should have the required code", "1.00 USDT", "The test field must be an amount in 4,EOS"},
+		{"should have the required precision", "1.00 EOS", "The test field must be an amount in 4,EOS"},
+		{"should have the required explicit precision", "1 0,EOS", "The test field must be an amount in 4,EOS"},
+		{"should have the required eos.Asset symbol", eos.Asset{Amount: 100, Symbol: eos.Symbol{Precision: 2, Symbol: "EOS"}}, "The test field must be an amount in 4,EOS"},
+
+		{"valid", "1.0000 EOS", ""},
+		{"valid negative", "-1.0000 EOS", ""},
+		{"valid explicit precision", "1.0000 4,EOS", ""},
+		{"valid eos.Asset", eos.Asset{Amount: 10000, Symbol: eos.Symbol{Precision: 4, Symbol: "EOS"}}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSAssetRuleFactory_NonNegative(t *testing.T) {
+	tag := "eos_asset_non_negative"
+	rule := EOSAssetRuleFactory(eos.Symbol{}, AssetNonNegative())
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a valid asset", "EOS", "The test field must be a valid EOS asset"},
+		{"should not be negative", "-1.0000 EOS", "The test field must be a non-negative amount"},
+		{"should not be negative eos.Asset", eos.Asset{Amount: -1, Symbol: eos.Symbol{Precision: 4, Symbol: "EOS"}}, "The test field must be a non-negative amount"},
+
+		{"valid", "1.0000 EOS", ""},
+		{"valid zero", "0.00 USDT", ""},
+		{"valid eos.Asset", eos.Asset{Amount: 10000, Symbol: eos.Symbol{Precision: 4, Symbol: "EOS"}}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	symbolRule := EOSAssetRuleFactory(eos.Symbol{Precision: 4, Symbol: "EOS"}, AssetNonNegative())
+	assert.EqualError(t, symbolRule("test", tag, "", "-1.0000 EOS"), "The test field must be a non-negative amount")
+	assert.EqualError(t, symbolRule("test", tag, "", "1.00 USDT"), "The test field must be an amount in 4,EOS")
+}
+
+func TestEOSExtendedAssetRule(t *testing.T) {
+	tag := "eos_extended_asset"
+	validator := func(field string, value interface{}) error {
+		return EOSExtendedAssetRule(field, tag, "", value)
+	}
+
+	asset := eos.Asset{Amount: 10000, Symbol: eos.Symbol{Precision: 4, Symbol: "EOS"}}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid extended asset"},
+		{"should be a known type", true, "The test field is not a known type for an EOS extended asset"},
+		{"should have a contract", "1.0000 EOS", "The test field must be a valid extended asset"},
+		{"should have a non-empty contract", "1.0000 EOS@", "The test field must be a valid extended asset"},
+		{"should have a valid asset", "1.0000 eos@eosio.token", "The test field must be a valid extended asset"},
+		{"should have a valid contract", "1.0000 EOS@Eosio.token", "The test field must be a valid extended asset"},
+		{"should split on the last separator", "1.0000 EOS@eosio@token", "The test field must be a valid extended asset"},
+		{"should have a contract eos.ExtendedAsset", eos.ExtendedAsset{Asset: asset}, "The test field must be a valid extended asset"},
+
+		{"valid", "1.0000 EOS@eosio.token", ""},
+		{"valid negative", "-0.5 WAX@eosio.token", ""},
+		{"valid eos.ExtendedAsset", eos.ExtendedAsset{Asset: asset, Contract: "eosio.token"}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSSymbolRule(t *testing.T) {
+	tag := "eos_symbol"
+	validator := func(field string, value interface{}) error {
+		return EOSSymbolRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS symbol"},
+		{"should be a string", true, "The test field is not a known type for an EOS symbol"},
+		{"should not be empty", "", "The test field must be a valid EOS symbol"},
+		{"should not be a name", "eosio", "The test field must be a valid EOS symbol"},
+		{"should have a precision", "EOS", "The test field must be a valid EOS symbol"},
+		{"should not have precision over 18", "19,EOS", "The test field must be a valid EOS symbol"},
+		{"should not have negative precision", "-1,EOS", "The test field must be a valid EOS symbol"},
+		{"should have an uppercase code", "4,eos", "The test field must be a valid EOS symbol"},
+		{"should not have a code longer than 7", "4,ABCDEFGH", "The test field must be a valid EOS symbol"},
+		{"should not have digits in code", "4,EOS1", "The test field must be a valid EOS symbol"},
+		{"should have a single separator", "4,EOS,EOS", "The test field must be a valid EOS symbol"},
+		{"should have a single leading separator", "4,,EOS", "The test field must be a valid EOS symbol"},
+		{"should not have a three digits precision", "004,EOS", "The test field must be a valid EOS symbol"},
+
+		{"valid", "4,EOS", ""},
+		{"valid zero precision", "0,EOS", ""},
+		{"valid max precision", "18,EOS", ""},
+		{"valid eos.Symbol", eos.Symbol{Precision: 4, Symbol: "EOS"}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSSymbolRuleFactory(t *testing.T) {
+	tag := "eos_symbol_exact"
+	rule := EOSSymbolRuleFactory(4, "EOS")
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a valid symbol", "EOS", "The test field must be a valid EOS symbol"},
+		{"should have the required precision", "2,EOS", "The test field must be symbol EOS with precision 4"},
+		{"should have the required code", "4,WAX", "The test field must be symbol EOS with precision 4"},
+		{"should have the required eos.Symbol", eos.Symbol{Precision: 8, Symbol: "EOS"}, "The test field must be symbol EOS with precision 4"},
+
+		{"valid", "4,EOS", ""},
+		{"valid eos.Symbol", eos.Symbol{Precision: 4, Symbol: "EOS"}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	anyPrecision := EOSSymbolRuleFactory(-1, "EOS")
+	assert.NoError(t, anyPrecision("test", tag, "", "8,EOS"))
+	assert.EqualError(t, anyPrecision("test", tag, "", "4,WAX"), "The test field must be symbol EOS")
+
+	anyCode := EOSSymbolRuleFactory(4, "")
+	assert.NoError(t, anyCode("test", tag, "", "4,WAX"))
+	assert.EqualError(t, anyCode("test", tag, "", "8,WAX"), "The test field must be a symbol with precision 4")
+}
+
+func TestEOSExtendedSymbolRule(t *testing.T) {
+	tag := "eos_extended_symbol"
+	validator := func(field string, value interface{}) error {
+		return EOSExtendedSymbolRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid extended symbol"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should have a contract", "4,EOS", "The test field must be a valid extended symbol"},
+		{"should have a non-empty contract", "4,EOS@", "The test field must be a valid extended symbol"},
+		{"should have a single separator", "4,EOS@eosio.token@eosio", "The test field must be a valid extended symbol"},
+		{"should have a valid symbol", "EOS@eosio.token", "The test field must be a valid extended symbol"},
+		{"should have a valid contract", "4,EOS@Eosio.token", "The test field must be a valid extended symbol"},
+
+		{"valid", "4,EOS@eosio.token", ""},
+		{"valid other contract", "8,WAX@bridge.wax", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSSymbolCodeRule(t *testing.T) {
+	tag := "eos_symbol_code"
+	validator := func(field string, value interface{}) error {
+		return EOSSymbolCodeRule(field, tag, "", value)
+	}
+
+	eosSymbolCode, err := eos.StringToSymbolCode("EOS")
+	require.NoError(t, err)
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS symbol code"},
+		{"should be a string", true, "The test field is not a known type for an EOS symbol code"},
+		{"should not be empty", "", "The test field must be a valid EOS symbol code"},
+		{"should not be a name", "eosio", "The test field must be a valid EOS symbol code"},
+		{"should not contain digits", "EOS1", "The test field must be a valid EOS symbol code"},
+		{"should not have a precision", "4,EOS", "The test field must be a valid EOS symbol code"},
+		{"should not be longer than 7", "ABCDEFGH", "The test field must be a valid EOS symbol code"},
+
+		{"valid", "EOS", ""},
+		{"valid single", "A", ""},
+		{"valid 7 chars", "ABCDEFG", ""},
+		{"valid eos.SymbolCode", eosSymbolCode, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSPublicKeyRule(t *testing.T) {
+	tag := "eos_public_key"
+	validator := func(field string, value interface{}) error {
+		return EOSPublicKeyRule(field, tag, "", value)
+	}
+
+	publicKey, err := ecc.NewPublicKey("EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+	require.NoError(t, err)
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS public key"},
+		{"should be a string", true, "The test field is not a known type for an EOS public key"},
+		{"should not be empty", "", "The test field must be a valid EOS public key"},
+		{"should have a known prefix", "XYZ6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV", "The test field must be a valid EOS public key"},
+		{"should have a valid checksum", "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDAAAA", "The test field must be a valid EOS public key"},
+		{"should have a valid checksum with new format", "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoAAAA", "The test field must be a valid EOS public key"},
+		{"should not be only a prefix", "PUB_K1_", "The test field must be a valid EOS public key"},
+		{"should have a known curve", "PUB_XX_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "The test field must be a valid EOS public key"},
+		{"should include the curve in the checksum", "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV", "The test field must be a valid EOS public key"},
+		{"should match the curve of the checksum", "PUB_R1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "The test field must be a valid EOS public key"},
+		{"should not be empty ecc.PublicKey", ecc.PublicKey{}, "The test field must be a valid EOS public key"},
+
+		{"valid legacy", "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV", ""},
+		{"valid new format", "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", ""},
+		{"valid new format R1", "PUB_R1_6FPFZqw5ahYrR9jD96yDbbDNTdKtNqRbze6oTDLntrsANgQKZu", ""},
+		{"valid ecc.PublicKey", publicKey, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSSignatureRule(t *testing.T) {
+	tag := "eos_signature"
+	validator := func(field string, value interface{}) error {
+		return EOSSignatureRule(field, tag, "", value)
+	}
+
+	signature, err := ecc.NewSignature("SIG_K1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvvxfvy79")
+	require.NoError(t, err)
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS signature"},
+		{"should be a string", true, "The test field is not a known type for an EOS signature"},
+		{"should not be empty", "", "The test field must be a valid EOS signature"},
+		{"should have a known prefix", "SIG_XX_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvvxfvy79", "The test field must be a valid EOS signature"},
+		{"should not be a public key", "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "The test field must be a valid EOS signature"},
+		{"should not be truncated", "SIG_K1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2p", "The test field must be a valid EOS signature"},
+		{"should have a valid checksum", "SIG_K1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvvxfAAAA", "The test field must be a valid EOS signature"},
+		{"should not be empty ecc.Signature", ecc.Signature{}, "The test field must be a valid EOS signature"},
+
+		{"valid K1", "SIG_K1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvvxfvy79", ""},
+		{"valid R1", "SIG_R1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvw3iRAnG", ""},
+		{"valid ecc.Signature", signature, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSSignatureSliceRule(t *testing.T) {
+	tag := "eos_signature_slice"
+	validator := func(field string, value interface{}) error {
+		return EOSSignatureSliceRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
+		{"should be an array", "", "The test field must be a string array"},
+		{"should have at least 1 element", []string{}, "The test field must have at least 1 element"},
+		{"should fail on single error", []string{"SIG_K1_"}, "The test[0] field must be a valid EOS signature"},
+		{"should fail if any element error", []string{"SIG_K1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvvxfvy79", "SIG_K1_"}, "The test[1] field must be a valid EOS signature"},
+
+		{"valid single", []string{"SIG_K1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvvxfvy79"}, ""},
+		{"valid multiple", []string{"SIG_K1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvvxfvy79", "SIG_R1_K4KM3eR661DnRcRn4JFG2sbJEEQgFKMAk9EqwRKcFtHKofnmR2X2pn1re7ZsQxGpePKVjSM5HygA5YLrjZVpujvw3iRAnG"}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSPermissionLevelRule(t *testing.T) {
+	tag := "eos_permission_level"
+	validator := func(field string, value interface{}) error {
+		return EOSPermissionLevelRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS permission level"},
+		{"should be a string", true, "The test field is not a known type for an EOS permission level"},
+		{"should not be empty", "", "The test field must be a valid EOS permission level"},
+		{"should have a permission", "eosio", "The test field must be a valid EOS permission level"},
+		{"should have a non-empty permission", "eosio@", "The test field must be a valid EOS permission level"},
+		{"should have a non-empty actor", "@active", "The test field must be a valid EOS permission level"},
+		{"should have a single separator", "eosio@active@extra", "The test field must be a valid EOS permission level"},
+		{"should not have a trailing separator", "eosio@active@", "The test field must be a valid EOS permission level"},
+		{"should not have consecutive separators", "eosio@@active", "The test field must be a valid EOS permission level"},
+		{"should have a valid actor", "eos6@active", "The test field must be a valid EOS permission level"},
+		{"should have a valid permission", "eosio@Active", "The test field must be a valid EOS permission level"},
+		{"should have a valid eos.PermissionLevel", eos.PermissionLevel{Actor: "eosio"}, "The test field must be a valid EOS permission level"},
+
+		{"valid", "eosio@active", ""},
+		{"valid with dots", "eosio.token@eosio.code", ""},
+		{"valid eos.PermissionLevel", eos.PermissionLevel{Actor: "eosio", Permission: "active"}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSAuthorityRule(t *testing.T) {
+	tag := "eos_authority"
+	validator := func(field string, value interface{}) error {
+		return EOSAuthorityRule(field, tag, "", value)
+	}
+
+	legacyKey := "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"
+	publicKey, err := ecc.NewPublicKey(legacyKey)
+	require.NoError(t, err)
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS authority"},
+		{"should be a known type", true, "The test field is not a known type for an EOS authority"},
+		{"should be valid JSON", `{"threshold":`, "The test field must be a valid EOS authority"},
+		{"should have a valid JSON key", `{"threshold":1,"keys":[{"key":"EOS6","weight":1}]}`, "The test field must be a valid EOS authority"},
+		{"should have a positive threshold", `{"threshold":0,"keys":[{"key":"` + legacyKey + `","weight":1}]}`, "The test field must be a valid EOS authority"},
+		{"should satisfy threshold", `{"threshold":3,"keys":[{"key":"` + legacyKey + `","weight":1}],"waits":[{"wait_sec":10,"weight":1}]}`, "The test field must be a valid EOS authority"},
+		{"should have valid accounts", `{"threshold":1,"accounts":[{"permission":{"actor":"eosio","permission":"6"},"weight":1}]}`, "The test.accounts[0] field must be a valid EOS permission level"},
+		{"should have valid keys", eos.Authority{Threshold: 1, Keys: []eos.KeyWeight{{Weight: 1}}}, "The test.keys[0] field must be a valid EOS public key"},
+		{"should have a valid map", map[string]interface{}{"threshold": -1}, "The test field must be a valid EOS authority"},
+
+		{"valid JSON string", `{"threshold":1,"keys":[{"key":"` + legacyKey + `","weight":1}]}`, ""},
+		{"valid JSON bytes", []byte(`{"threshold":2,"keys":[{"key":"` + legacyKey + `","weight":1}],"accounts":[{"permission":{"actor":"eosio","permission":"active"},"weight":1}]}`), ""},
+		{"valid map", map[string]interface{}{"threshold": 1, "accounts": []interface{}{map[string]interface{}{"permission": map[string]interface{}{"actor": "eosio", "permission": "active"}, "weight": 1}}}, ""},
+		{"valid eos.Authority", eos.Authority{Threshold: 1, Keys: []eos.KeyWeight{{PublicKey: publicKey, Weight: 1}}}, ""},
+		{"valid *eos.Authority", &eos.Authority{Threshold: 1, Keys: []eos.KeyWeight{{PublicKey: publicKey, Weight: 1}}}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSWeightRule(t *testing.T) {
+	tag := "eos_weight"
+	validator := func(field string, value interface{}) error {
+		return EOSWeightRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid authority weight"},
+		{"should be a known type", true, "The test field is not a known type for an authority weight"},
+		{"should not be zero", "0", "The test field must be a valid authority weight"},
+		{"should not be zero uint16", uint16(0), "The test field must be a valid authority weight"},
+		{"should not be negative", "-1", "The test field must be a valid authority weight"},
+		{"should not overflow uint16", "65536", "The test field must be a valid authority weight"},
+		{"should not overflow uint16 int", 65536, "The test field must be a valid authority weight"},
+
+		{"valid min", "1", ""},
+		{"valid max", "65535", ""},
+		{"valid uint16", uint16(10), ""},
+		{"valid int", 10, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSThresholdRule(t *testing.T) {
+	tag := "eos_threshold"
+	validator := func(field string, value interface{}) error {
+		return EOSThresholdRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid authority threshold"},
+		{"should be a known type", true, "The test field is not a known type for an authority threshold"},
+		{"should not be zero", "0", "The test field must be a valid authority threshold"},
+		{"should not be zero int", 0, "The test field must be a valid authority threshold"},
+		{"should not be negative", -1, "The test field must be a valid authority threshold"},
+		{"should not overflow uint32", "4294967296", "The test field must be a valid authority threshold"},
+		{"should not be a non-integral float64", 1.5, "The test field must be a valid authority threshold"},
+
+		{"valid min", "1", ""},
+		{"valid max", "4294967295", ""},
+		{"valid uint32", uint32(2), ""},
+		{"valid json.Number", json.Number("2"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSRAMBytesRule(t *testing.T) {
+	tag := "eos_ram_bytes"
+	validator := func(field string, value interface{}) error {
+		return EOSRAMBytesRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid RAM byte count"},
+		{"should be a known type", true, "The test field is not a known type for a RAM byte count"},
+		{"should not be negative", "-1", "The test field must be a valid RAM byte count"},
+		{"should not be a negative int64", int64(-1), "The test field must be a valid RAM byte count"},
+		{"should not overflow uint64", "18446744073709551616", "The test field must be a valid RAM byte count"},
+		{"should not be a float", 1.5, "The test field must be a valid RAM byte count"},
+
+		{"valid zero", "0", ""},
+		{"valid max", "18446744073709551615", ""},
+		{"valid uint64", uint64(8192), ""},
+		{"valid uint32", uint32(8192), ""},
+		{"valid json.Number", json.Number("8192"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSABIRule(t *testing.T) {
+	tag := "eos_abi"
+	validator := func(field string, value interface{}) error {
+		return EOSABIRule(field, tag, "", value)
+	}
+
+	validABI := `{
+		"version": "eosio::abi/1.1",
+		"types": [{"new_type_name": "account_name", "type": "name"}, {"new_type_name": "account_row", "type": "account"}],
+		"structs": [
+			{"name": "transfer", "base": "", "fields": [{"name": "from", "type": "account_name"}]},
+			{"name": "account", "base": "", "fields": [{"name": "balance", "type": "asset"}]}
+		],
+		"actions": [{"name": "transfer", "type": "transfer", "ricardian_contract": ""}],
+		"tables": [{"name": "accounts", "index_type": "i64", "type": "account_row"}]
+	}`
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS ABI"},
+		{"should be a known type", true, "The test field is not a known type for an EOS ABI"},
+		{"should be valid JSON", `{"version":`, "The test field must be a valid EOS ABI"},
+		{"should have a known version", `{"version": "eosio::abi/2.0"}`, "The test field must be a valid EOS ABI"},
+		{"should not have duplicate structs", `{"version": "eosio::abi/1.0", "structs": [{"name": "a"}, {"name": "a"}]}`, "The test field must be a valid EOS ABI"},
+		{"should have action declared struct", `{"version": "eosio::abi/1.0", "structs": [{"name": "a"}], "actions": [{"name": "b", "type": "b"}]}`, "The test field must be a valid EOS ABI"},
+		{"should have table declared struct", `{"version": "eosio::abi/1.0", "structs": [{"name": "a"}], "tables": [{"name": "b", "type": "b"}]}`, "The test field must be a valid EOS ABI"},
+		{"should not loop on cyclic aliases", `{"version": "eosio::abi/1.0", "types": [{"new_type_name": "a", "type": "b"}, {"new_type_name": "b", "type": "a"}], "tables": [{"name": "b", "type": "a"}]}`, "The test field must be a valid EOS ABI"},
+
+		{"valid minimal", `{"version": "eosio::abi/1.0"}`, ""},
+		{"valid string", validABI, ""},
+		{"valid bytes", []byte(validABI), ""},
+		{"valid eos.ABI", eos.ABI{Version: "eosio::abi/1.1", Structs: []eos.StructDef{{Name: "a"}}, Actions: []eos.ActionDef{{Name: "a", Type: "a"}}}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSPackedTransactionRule(t *testing.T) {
+	tag := "eos_packed_transaction"
+	validator := func(field string, value interface{}) error {
+		return EOSPackedTransactionRule(field, tag, "", value)
+	}
+
+	packedTrx := "8bc2a35cf56f2ed4e8b5000000000000000000"
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid hexadecimal"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should be hexadecimal", "zz", "The test field must be a valid hexadecimal"},
+		{"should have an even length", "abc", "The test field must have an even number of characters"},
+		{"should be a transaction", "8bc2a35c", "The test field must be a valid packed transaction"},
+		{"should be a transaction bytes", []byte{0x01}, "The test field must be a valid packed transaction"},
+
+		{"valid", packedTrx, ""},
+		{"valid bytes", []byte{0x8b, 0xc2, 0xa3, 0x5c, 0xf5, 0x6f, 0x2e, 0xd4, 0xe8, 0xb5, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSMemoRule(t *testing.T) {
+	tag := "eos_memo"
+	validator := func(field string, value interface{}) error {
+		return EOSMemoRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should be at most 256 bytes", strings.Repeat("a", 257), "The test field must be at most 256 bytes"},
+		{"should count bytes not runes", strings.Repeat("é", 129), "The test field must be at most 256 bytes"},
+
+		{"valid nil", nil, ""},
+		{"valid empty", "", ""},
+		{"valid 256 bytes", strings.Repeat("a", 256), ""},
+		{"valid 256 bytes runes", strings.Repeat("é", 128), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSScopeRule(t *testing.T) {
+	tag := "eos_scope"
+	validator := func(field string, value interface{}) error {
+		return EOSScopeRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a known type", true, "The test field is not a known type for a table scope"},
+		{"should be a name or a number", "EOS", "The test field must be a valid table scope"},
+		{"should not be a negative number", "-1", "The test field must be a valid table scope"},
+		{"should not overflow uint64", "18446744073709551616", "The test field must be a valid table scope"},
+		{"should be a valid eos.Name", eos.Name("6"), "The test field must be a valid table scope"},
+		{"should not be a negative int", -1, "The test field must be a valid table scope"},
+		{"should not be a negative int64", int64(-1), "The test field must be a valid table scope"},
+		{"should not be a negative json.Number", json.Number("-1"), "The test field must be a valid table scope"},
+		{"should not be a fractional json.Number", json.Number("1.5"), "The test field must be a valid table scope"},
+
+		{"valid nil", nil, ""},
+		{"valid name", "eosio.token", ""},
+		{"valid number", "6138663577826885632", ""},
+		{"valid max uint64", "18446744073709551615", ""},
+		{"valid eos.Name", eos.Name("eosio"), ""},
+		{"valid int", 10, ""},
+		{"valid uint64", uint64(6138663577826885632), ""},
+		{"valid int64", int64(10), ""},
+		{"valid json.Number", json.Number("6138663577826885632"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNamesListRule(t *testing.T) {
+	tag := "eos_names_list"
+	rule := EOSNamesListRuleFactory("|", 2)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max macCount element", "eos|eos|eos", "The test field must have at most 2 elements"},
+		{"should fail on single error", "6", "The test[0] field must be a valid EOS name"},
+		{"should fail if any element error", "ab|6", "The test[1] field must be a valid EOS name"},
+
+		{"valid single", "ab", ""},
+		{"valid multiple", "ded|eos", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNamesListRule_Singular(t *testing.T) {
+	tag := "eos_names_list"
+	rule := EOSNamesListRuleFactory("|", 1)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max 1 element", "eos|eos", "The test field must have at most 1 element"},
+
+		{"valid single", "ab", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNamesListRuleFactoryUnique(t *testing.T) {
+	tag := "eos_names_list"
+	rule := EOSNamesListRuleFactoryUnique("|", 3)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should have at max macCount element", "eos|eos|eos|eos", "The test field must have at most 3 elements"},
+		{"should fail if any element error", "eos|6|eos", "The test[1] field must be a valid EOS name"},
+		{"should not contain duplicates", "eos|eosio|eos", `The test field must not contain duplicate elements ("eos" is duplicated)`},
+
+		{"valid single", "eos", ""},
+		{"valid multiple", "eos|eosio|bob", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNamesListRule_TrimSpace(t *testing.T) {
+	tag := "eos_names_list"
+	rule := EOSNamesListRuleFactory(",", 3, ListTrimSpace())
+	untrimmedRule := EOSNamesListRuleFactory(",", 3)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should fail on inner invalid element", "eos, 6 ,eosio", "The test[1] field must be a valid EOS name"},
+		{"should fail on inner space", "eos, eo sio", "The test[1] field must be a valid EOS name"},
+
+		{"valid spaces after separator", "eos, eosio", ""},
+		{"valid surrounding spaces", " eos ,\teosio , bob ", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	assert.EqualError(t, untrimmedRule("test", tag, "", "eos, eosio"), "The test[1] field must be a valid EOS name")
+}
+
+func TestEOSNamesListRule_EmptyElements(t *testing.T) {
+	tag := "eos_names_list"
+	rule := EOSNamesListRuleFactory("|", 2)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should have at least 1 non empty element", "||", "The test field must have at least 1 element"},
+		{"should index non empty elements", "eos||6", "The test[1] field must be a valid EOS name"},
+		{"should have at max macCount non empty element", "eos|eosio|bob|", "The test field must have at most 2 elements"},
+
+		{"valid trailing separator", "eos|eosio|", ""},
+		{"valid empty elements not counted", "eos||eosio|", ""},
+		{"valid leading separator", "|eos", ""},
+		{"valid whitespace only element", "eos| |eosio", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSNamesListRule_KeepEmptyElements(t *testing.T) {
+	tag := "eos_names_list"
+	rule := EOSNamesListRuleFactory("|", 3, ListSkipEmpty(false))
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should count empty elements", "eos|eosio||", "The test field must have at most 3 elements"},
+		{"should index empty elements", "eos||6", "The test[2] field must be a valid EOS name"},
+
+		{"valid trailing separator, empty name", "eos|eosio|", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	symbolCodesRule := ListRuleFactory("|", 3, EOSSymbolCodeRule, ListSkipEmpty(false))
+	assert.EqualError(t, symbolCodesRule("test", tag, "", "EOS|WAX|"), "The test[2] field must be a valid EOS symbol code")
+	assert.NoError(t, ListRuleFactory("|", 3, EOSSymbolCodeRule)("test", tag, "", "EOS|WAX|"))
+}
+
+func TestEOSExtendedNamesListRule(t *testing.T) {
+	tag := "eos_extended_names_list"
+	rule := EOSExtendedNamesListRuleFactory("|", 3)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max macCount element", "eos|eos|eos|eos", "The test field must have at most 3 elements"},
+		{"should fail on single error", "6", "The test[0] field must be a valid EOS name"},
+		{"should fail if any element error", "ab|6", "The test[1] field must be a valid EOS name"},
+
+		{"valid single", "ab", ""},
+		{"valid multiple", "ded|eos", ""},
+		{"valid multiple symbol", "ded|eos|EOS", ""},
+		{"valid multiple symbol code", "ded|4,EOS", ""},
+		{"valid multiple mixed", "ded|EOS|4,EOS", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSPublicKeyListRule(t *testing.T) {
+	tag := "eos_public_keys_list"
+	rule := EOSPublicKeyListRuleFactory(",", 3)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	legacyKey := "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"
+	newKey := "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"
+	r1Key := "PUB_R1_6FPFZqw5ahYrR9jD96yDbbDNTdKtNqRbze6oTDLntrsANgQKZu"
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max macCount element", strings.Join([]string{legacyKey, legacyKey, legacyKey, legacyKey}, ","), "The test field must have at most 3 elements"},
+		{"should fail on single error", "EOS", "The test[0] field must be a valid EOS public key"},
+		{"should fail if any element error", strings.Join([]string{legacyKey, newKey, "EOS"}, ","), "The test[2] field must be a valid EOS public key"},
+		{"should fail on a key with a legacy checksum", strings.Join([]string{legacyKey, "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"}, ","), "The test[1] field must be a valid EOS public key"},
+
+		{"valid single", legacyKey, ""},
+		{"valid multiple", strings.Join([]string{legacyKey, newKey}, ","), ""},
+		{"valid mixed formats", strings.Join([]string{legacyKey, newKey, r1Key}, ","), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSTrxIDListRule(t *testing.T) {
+	tag := "eos_trx_ids_list"
+	rule := EOSTrxIDListRuleFactory(",", 2)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	trxID := "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148"
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max macCount element", strings.Join([]string{trxID, trxID, trxID}, ","), "The test field must have at most 2 elements"},
+		{"should fail on single error", "zz", "The test[0] field must be a valid hexadecimal"},
+		{"should fail if any element error", trxID + ",abcd", "The test[1] field must have exactly 64 characters"},
+
+		{"valid single", trxID, ""},
+		{"valid multiple", trxID + "," + trxID, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSAssetListRule(t *testing.T) {
+	tag := "eos_assets_list"
+	rule := EOSAssetListRuleFactory("|", 2)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	asset := eos.Asset{Amount: 10000, Symbol: eos.Symbol{Precision: 4, Symbol: "EOS"}}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max maxCount element", "1.0000 EOS|2.0000 EOS|3.0000 EOS", "The test field must have at most 2 elements"},
+		{"should fail on single error", "EOS", "The test[0] field must be a valid EOS asset"},
+		{"should fail if any element error", "1.0000 EOS|1.0 eos", "The test[1] field must be a valid EOS asset"},
+		{"should have at least 1 eos.Asset", []eos.Asset{}, "The test field must have at least 1 element"},
+		{"should have at max maxCount eos.Asset", []eos.Asset{asset, asset, asset}, "The test field must have at most 2 elements"},
+		{"should have at max maxCount *[]eos.Asset", &[]eos.Asset{asset, asset, asset}, "The test field must have at most 2 elements"},
+
+		{"valid single", "1.0000 EOS", ""},
+		{"valid multiple", "1.0000 EOS|0.5 WAX", ""},
+		{"valid []eos.Asset", []eos.Asset{asset, asset}, ""},
+		{"valid *[]eos.Asset", &[]eos.Asset{asset, asset}, ""},
+		{"valid nil *[]eos.Asset", (*[]eos.Asset)(nil), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSSymbolListRule(t *testing.T) {
+	tag := "eos_symbols_list"
+	rule := EOSSymbolListRuleFactory(",", 2)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	symbol := eos.Symbol{Precision: 4, Symbol: "EOS"}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max maxCount element", "4,EOS,4,EOS,4,EOS", "The test field must have at most 2 elements"},
+		{"should fail on single error", "EOS", "The test[0] field must be a valid EOS symbol"},
+		{"should have at least 1 eos.Symbol", []eos.Symbol{}, "The test field must have at least 1 element"},
+		{"should fail if any eos.Symbol error", []eos.Symbol{symbol, {Precision: 4, Symbol: "eos"}}, "The test[1] field must be a valid EOS symbol"},
+		{"should fail if any *[]eos.Symbol error", &[]eos.Symbol{symbol, {Precision: 4, Symbol: "eos"}}, "The test[1] field must be a valid EOS symbol"},
+
+		{"valid []eos.Symbol", []eos.Symbol{symbol, {Precision: 8, Symbol: "WAX"}}, ""},
+		{"valid *[]eos.Symbol", &[]eos.Symbol{symbol, {Precision: 8, Symbol: "WAX"}}, ""},
+		{"valid nil *[]eos.Symbol", (*[]eos.Symbol)(nil), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	pipeRule := EOSSymbolListRuleFactory("|", 2)
+	assert.NoError(t, pipeRule("test", tag, "", "4,EOS|8,WAX"))
+	assert.EqualError(t, pipeRule("test", tag, "", "4,EOS|EOS"), "The test[1] field must be a valid EOS symbol")
+}
+
+func TestListRule(t *testing.T) {
+	tag := "hex_list"
+	rule := ListRuleFactory(",", 2, HexRule)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	deprecatedRule := StringListRuleFactory(",", 2, HexRule)
+	deprecatedValidator := func(field string, value interface{}) error {
+		return deprecatedRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max macCount element", "ab,cd,ef", "The test field must have at most 2 elements"},
+		{"should fail on single error", "zz", "The test[0] field must be a valid hexadecimal"},
+		{"should fail if any element error", "ab,a", "The test[1] field must have an even number of characters"},
+
+		{"valid single", "ab", ""},
+		{"valid multiple", "ab,cd", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+	runRuleTestCases(t, tag+"_deprecated", tests, deprecatedValidator)
+}
+
+func TestListRuleAll(t *testing.T) {
+	tag := "eos_names_list_all"
+	rule := ListRuleFactoryAll("|", 3, EOSNameRule)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should have at least 1 element", "", "The test field must have at least 1 element"},
+		{"should have at max macCount element", "eos|eos|eos|eos", "The test field must have at most 3 elements"},
+		{"should fail on single error", "6", "The test[0] field must be a valid EOS name"},
+		{"should report all element errors", "6|7|ab", "The test[0] field must be a valid EOS name; The test[1] field must be a valid EOS name"},
+		{"should report non-contiguous element errors", "6|ab|7", "The test[0] field must be a valid EOS name; The test[2] field must be a valid EOS name"},
+
+		{"valid single", "ab", ""},
+		{"valid multiple", "ded|eos", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	longRule := ListRuleFactoryAll("|", 12, EOSNameRule)
+	assert.EqualError(t, longRule("test", tag, "", "a|b|6|d|e|f|g|h|i|j|7"), "The test[2] field must be a valid EOS name; The test[10] field must be a valid EOS name")
+
+	var errs ValidationErrors
+	require.True(t, errors.As(validator("test", "6|ab|7"), &errs))
+	assert.Contains(t, errs, "test[0]")
+	assert.Contains(t, errs, "test[2]")
+	assert.True(t, errors.Is(errs, ErrInvalidEOSName))
+}
+
+func TestEOSTrxIDRule(t *testing.T) {
+	tag := "eos_trx_id"
+	validator := func(field string, value interface{}) error {
+		return EOSTrxIDRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid hexadecimal"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should contains something", "", "The test field must be a valid hexadecimal"},
+		{"should contains a least two characters", "a", "The test field must have an even number of characters"},
+		{"should not contains invalid characters", "az", "The test field must be a valid hexadecimal"},
+		{"should be a multple of 2", "ab01020", "The test field must have an even number of characters"},
+		{"should be long enough", "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd1", "The test field must have exactly 64 characters"},
+
+		{"should have 32 bytes", make([]byte, 31), "The test field must have exactly 64 characters"},
+		{"should have 32 bytes eos.Checksum256", eos.Checksum256(make([]byte, 31)), "The test field must have exactly 64 characters"},
+
+		{"valid", "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", ""},
+		{"valid", "D8FE02221408FBCC221D1207C1B8CC67E0D9B3CA1C6005A36EA10428DD7FD148", ""},
+		{"valid bytes", make([]byte, 32), ""},
+		{"valid eos.Checksum256", eos.Checksum256(make([]byte, 32)), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSChecksum256Rule(t *testing.T) {
+	tag := "eos_checksum256"
+	validator := func(field string, value interface{}) error {
+		return EOSChecksum256Rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid 256-bit checksum"},
+		{"should be a string", true, "The test field is not a known type for a 256-bit checksum"},
+		{"should contains something", "", "The test field must be a valid 256-bit checksum"},
+		{"should not contains invalid characters", "z8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", "The test field must be a valid 256-bit checksum"},
+		{"should not be too short", "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd1", "The test field must be a valid 256-bit checksum"},
+		{"should not be too long", "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd14800", "The test field must be a valid 256-bit checksum"},
+		{"should have 32 bytes", []byte{0x01, 0x02}, "The test field must be a valid 256-bit checksum"},
+		{"should have 32 bytes eos.Checksum256", eos.Checksum256{0x01, 0x02}, "The test field must be a valid 256-bit checksum"},
+
+		{"valid", "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", ""},
+		{"valid uppercase", "D8FE02221408FBCC221D1207C1B8CC67E0D9B3CA1C6005A36EA10428DD7FD148", ""},
+		{"valid bytes", make([]byte, 32), ""},
+		{"valid eos.Checksum256", eos.Checksum256(make([]byte, 32)), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSBlockIDRule(t *testing.T) {
+	tag := "eos_block_id"
+	validator := func(field string, value interface{}) error {
+		return EOSBlockIDRule(field, tag, "", value)
+	}
+
+	blockID, err := hex.DecodeString("0000000a1408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148")
+	require.NoError(t, err)
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid EOS block id"},
+		{"should be a string", true, "The test field is not a known type for an EOS block id"},
+		{"should contains something", "", "The test field must be a valid EOS block id"},
+		{"should not contains invalid characters", "0000000az408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", "The test field must be a valid EOS block id"},
+		{"should be long enough", "0000000a1408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd1", "The test field must be a valid EOS block id"},
+		{"should not have a 0 block num", "000000001408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", "The test field must be a valid EOS block id"},
+
+		{"valid", "0000000a1408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", ""},
+		{"valid uppercase", "0000000A1408FBCC221D1207C1B8CC67E0D9B3CA1C6005A36EA10428DD7FD148", ""},
+		{"valid eos.Checksum256", eos.Checksum256(blockID), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSChainIDRule(t *testing.T) {
+	tag := "eos_chain_id"
+	validator := func(field string, value interface{}) error {
+		return EOSChainIDRule(field, tag, "", value)
+	}
+
+	mainnet := "aca376f206b8fc25a6ed44dbdc66547c36c6c33e3a119ffbeaef943642f0e906"
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid chain id"},
+		{"should be a known type", true, "The test field is not a known type for a chain id"},
+		{"should not be empty", "", "The test field must be a valid chain id"},
+		{"should have 64 characters", mainnet[:62], "The test field must be a valid chain id"},
+		{"should be hexadecimal", "zz" + mainnet[2:], "The test field must be a valid chain id"},
+		{"should have 32 bytes", make([]byte, 31), "The test field must be a valid chain id"},
+
+		{"valid", mainnet, ""},
+		{"valid uppercase", strings.ToUpper(mainnet), ""},
+		{"valid eos.Checksum256", eos.Checksum256(make([]byte, 32)), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSChainIDRuleFactory(t *testing.T) {
+	tag := "eos_chain_id_allowed"
+	mainnet := "aca376f206b8fc25a6ed44dbdc66547c36c6c33e3a119ffbeaef943642f0e906"
+	jungle := "e70aaab8997e1dfce58fbfac80cbbb8fecec7b99cf982a9444273cbc64c41473"
+
+	rule := EOSChainIDRuleFactory(mainnet, strings.ToUpper(jungle))
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	mainnetBytes, _ := hex.DecodeString(mainnet)
+
+	tests := []ruleTestCase{
+		{"should be a valid chain id", "abcd", "The test field must be a valid chain id"},
+		{"should be allowed", strings.Repeat("ab", 32), "The test field is not a supported chain id"},
+
+		{"valid allowed", mainnet, ""},
+		{"valid allowed case insensitive", jungle, ""},
+		{"valid allowed eos.Checksum256", eos.Checksum256(mainnetBytes), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestEOSTimePointSecRule(t *testing.T) {
+	tag := "eos_time_point_sec"
+	validator := func(field string, value interface{}) error {
+		return EOSTimePointSecRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field is not a valid EOS time point"},
+		{"should be a string", true, "The test field is not a known type for an EOS time point"},
+		{"should not be empty", "", "The test field is not a valid EOS time point"},
+		{"should not have a timezone offset", "2019-01-12T15:23:34+00:00", "The test field is not a valid EOS time point"},
+		{"should not have a Z suffix", "2019-01-12T15:23:34Z", "The test field is not a valid EOS time point"},
+		{"should not have sub-second precision", "2019-01-12T15:23:34.500", "The test field is not a valid EOS time point"},
+		{"should have a T separator", "2019-01-12 15:23:34", "The test field is not a valid EOS time point"},
+
+		{"valid", "2019-01-12T15:23:34", ""},
+		{"valid eos.TimePointSec", eos.TimePointSec(1547306614), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestCursorRule(t *testing.T) {
+	tag := "cursor"
+	validator := func(field string, value interface{}) error {
+		return CursorRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"happy path", "Wf_IQ72XbdmObmHnniHTKPazJ8IwBwxqBl3tfhdIh4z19XLF2p6hU2N9PUzZla_yjhLjTQis29jKHC9_ocZY7dDuyr9g73JpQS8pxYjp-eflePPybA==", ""},
+		{"empty cursor", "", ""},
+		{"invalid characters in cursor", "-----==", "The test field is not a valid cursor"},
+		{"invalid cursor", "abc", "The test field is not a valid cursor"},
+		{"valid nil", nil, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestCursorRuleFactory(t *testing.T) {
+	tag := "cursor"
+	cursor := "Wf_IQ72XbdmObmHnniHTKPazJ8IwBwxqBl3tfhdIh4z19XLF2p6hU2N9PUzZla_yjhLjTQis29jKHC9_ocZY7dDuyr9g73JpQS8pxYjp-eflePPybA=="
+
+	rule := CursorRuleFactory(len(cursor))
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be too long", cursor + "AAAA", "The test field cursor is too long"},
+		{"should not be too long even if invalid", strings.Repeat("-", 1024), "The test field cursor is too long"},
+		{"should be a valid cursor", "abc", "The test field is not a valid cursor"},
+
+		{"valid empty cursor", "", ""},
+		{"valid max length", cursor, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestCursorVersionRuleFactory(t *testing.T) {
+	tag := "cursor_version"
+	rule := CursorVersionRuleFactory(3)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	toOpaque := func(in string) string {
+		out, err := opaque.ToOpaque(in)
+		require.NoError(t, err)
+
+		return out
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should be a valid cursor", "abc", "The test field is not a valid cursor"},
+		{"should not be a legacy cursor", toOpaque("1:100:abc:0:trx"), "The test field cursor version is unsupported"},
+		{"should not be an older version", toOpaque("c2:1:100:abc:0"), "The test field cursor version is unsupported"},
+
+		{"valid empty cursor", "", ""},
+		{"valid min version", toOpaque("c3:1:100:abc:0"), ""},
+		{"valid newer version", toOpaque("c12:1:100:abc:0"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func BenchmarkCursorRule(b *testing.B) {
+	cursor := "Wf_IQ72XbdmObmHnniHTKPazJ8IwBwxqBl3tfhdIh4z19XLF2p6hU2N9PUzZla_yjhLjTQis29jKHC9_ocZY7dDuyr9g73JpQS8pxYjp-eflePPybA=="
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := CursorRule("test", "cursor", "", cursor); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestBase64URLRule(t *testing.T) {
+	tag := "base64url"
+	validator := func(field string, value interface{}) error {
+		return Base64URLRule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should not contains standard alphabet plus", "ab+d", "The test field must be valid base64url"},
+		{"should not contains standard alphabet slash", "ab/d", "The test field must be valid base64url"},
+		{"should not contains invalid characters", "ab!d", "The test field must be valid base64url"},
+		{"should have a valid length", "abcde", "The test field must be valid base64url"},
+		{"should have a valid padding", "abc==", "The test field must be valid base64url"},
+
+		{"valid empty", "", ""},
+		{"valid without padding", "ab-_", ""},
+		{"valid unpadded partial", "abc", ""},
+		{"valid with padding", "abc=", ""},
+		{"valid with double padding", "ab==", ""},
+		{"valid nil", nil, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	nonEmptyRule := Base64URLRuleFactory(false)
+	nonEmptyValidator := func(field string, value interface{}) error {
+		return nonEmptyRule(field, tag, "", value)
+	}
+
+	nonEmptyTests := []ruleTestCase{
+		{"should not be empty", "", "The test field must be valid base64url"},
+
+		{"valid", "ab-_", ""},
+	}
+
+	runRuleTestCases(t, tag+"_non_empty", nonEmptyTests, nonEmptyValidator)
+}
+
+func TestBase58Rule(t *testing.T) {
+	tag := "base58"
+	validator := func(field string, value interface{}) error {
+		return Base58Rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be valid base58"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be empty", "", "The test field must be valid base58"},
+		{"should not contains 0", "abc0", "The test field must be valid base58"},
+		{"should not contains O", "abcO", "The test field must be valid base58"},
+		{"should not contains I", "abcI", "The test field must be valid base58"},
+		{"should not contains l", "abcl", "The test field must be valid base58"},
+		{"should not contains invalid characters", "abc+", "The test field must be valid base58"},
+
+		{"valid", "6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV", ""},
+		{"valid without checksum", "6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDAAAA", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	checksumRule := Base58CheckRuleFactory(true)
+	checksumValidator := func(field string, value interface{}) error {
+		return checksumRule(field, tag, "", value)
+	}
+
+	checksumTests := []ruleTestCase{
+		{"should not contains invalid characters", "abc0", "The test field must be valid base58"},
+		{"should be long enough for checksum", "abc", "The test field must have a valid base58 checksum"},
+		{"should have a valid checksum", "6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDAAAA", "The test field must have a valid base58 checksum"},
+
+		{"valid", "6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV", ""},
+	}
+
+	runRuleTestCases(t, tag+"_check", checksumTests, checksumValidator)
+}
+
+func TestIntRangeRuleFactory(t *testing.T) {
+	tag := "int_range"
+	rule := IntRangeRuleFactory(-10, 100)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be an integer"},
+		{"should be a known type", true, "The test field is not a known type for an integer"},
+		{"should be an integer", "abc", "The test field must be an integer"},
+		{"should not be a float", 1.5, "The test field must be an integer"},
+		{"should not be lower than min", "-11", "The test field must be between -10 and 100"},
+		{"should not be greater than max", 101, "The test field must be between -10 and 100"},
+		{"should not be greater than max json.Number", json.Number("101"), "The test field must be between -10 and 100"},
+		{"should not overflow int64", "99999999999999999999", "The test field must be between -10 and 100"},
+
+		{"valid min", "-10", ""},
+		{"valid max", 100, ""},
+		{"valid int64", int64(50), ""},
+		{"valid json.Number", json.Number("0"), ""},
+		{"valid integral float64", 42.0, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+	assert.True(t, errors.Is(validator("test", 101), ErrOutOfRange))
+}
+
+func TestLengthRangeRuleFactory(t *testing.T) {
+	tag := "length_range"
+	rule := LengthRangeRuleFactory(2, 5)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be too short", "a", "The test field must be at least 2 characters"},
+		{"should not be too long", "abcdef", "The test field must be at most 5 characters"},
+
+		{"valid min", "ab", ""},
+		{"valid max", "abcde", ""},
+		{"valid counting runes", "ééééé", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	singular := LengthRangeRuleFactory(1, 1)
+	assert.EqualError(t, singular("test", tag, "", ""), "The test field must be at least 1 character")
+	assert.EqualError(t, singular("test", tag, "", "ab"), "The test field must be at most 1 character")
+}
+
+func TestByteLengthRangeRuleFactory(t *testing.T) {
+	tag := "byte_length_range"
+	rule := ByteLengthRangeRuleFactory(2, 5)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be too short", "a", "The test field must be at least 2 characters"},
+		{"should not be too long counting bytes", "ééé", "The test field must be at most 5 characters"},
+
+		{"valid min", "ab", ""},
+		{"valid max", "abcde", ""},
+		{"valid multi-byte", "éé", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestMinLengthRuleFactory(t *testing.T) {
+	tag := "min_length"
+	rule := MinLengthRuleFactory(3)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be too short", "ab", "The test field must be at least 3 characters"},
+
+		{"valid min", "abc", ""},
+		{"valid longer", strings.Repeat("a", 1024), ""},
+		{"valid counting runes", "ééé", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestMaxLengthRuleFactory(t *testing.T) {
+	tag := "max_length"
+	rule := MaxLengthRuleFactory(3)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be too long", "abcd", "The test field must be at most 3 characters"},
+
+		{"valid empty", "", ""},
+		{"valid max", "abc", ""},
+		{"valid counting runes", "ééé", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+	assert.True(t, errors.Is(validator("test", "abcd"), ErrTooLong))
+}
+
+func TestExactLengthRuleFactory(t *testing.T) {
+	tag := "exact_length"
+	rule := ExactLengthRuleFactory(12)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be shorter", "abc", "The test field must have exactly 12 characters"},
+		{"should not be longer", "abcdefghijklm", "The test field must have exactly 12 characters"},
+
+		{"valid", "abcdefghijkl", ""},
+		{"valid counting runes", "éééééééééééé", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+	assert.EqualError(t, ExactLengthRuleFactory(1)("test", tag, "", "ab"), "The test field must have exactly 1 character")
+}
+
+func TestRegexRuleFactory(t *testing.T) {
+	tag := "region"
+	rule := RegexRuleFactory(`[a-z]{2}-[0-9]+`, "The {field} field must be a valid region code")
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid region code"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should not be empty", "", "The test field must be a valid region code"},
+		{"should match the whole string", "xus-1", "The test field must be a valid region code"},
+		{"should match the whole string end", "us-1a", "The test field must be a valid region code"},
+
+		{"valid", "us-1", ""},
+		{"valid long", "ca-123", ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	assert.Panics(t, func() { RegexRuleFactory(`[a-z`, "invalid") })
+	assert.True(t, errors.Is(rule("test", tag, "", "xx"), ErrInvalidFormat))
+}
+
+func TestOneOfRuleFactory(t *testing.T) {
+	tag := "order"
+	rule := OneOfRuleFactory("asc", "desc")
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be one of: asc, desc"},
 		{"should be a string", true, "The test field must be a string"},
-		{"should not contains invalid characters", "!", "The test field must be a valid EOS block num"},
+		{"should be one of", "random", "The test field must be one of: asc, desc"},
+		{"should be case-sensitive", "ASC", "The test field must be one of: asc, desc"},
 
-		{"valid block num", "10", ""},
+		{"valid asc", "asc", ""},
+		{"valid desc", "desc", ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 }
 
-func TestEOSNameRule(t *testing.T) {
-	tag := "eos_name"
+func TestOneOfFoldRuleFactory(t *testing.T) {
+	tag := "order"
+	rule := OneOfFoldRuleFactory("asc", "Desc")
 	validator := func(field string, value interface{}) error {
-		return EOSNameRule(field, tag, "", value)
+		return rule(field, tag, "", value)
 	}
 
 	tests := []ruleTestCase{
-		{"should be a string", true, "The test field is not a known type for an EOS name"},
-		{"should not contains invalid characters", "6", "The test field must be a valid EOS name"},
-		{"should not be longer than 13", "abcdefghigklma", "The test field must be a valid EOS name"},
+		{"should be one of", "random", "The test field must be one of: asc, Desc"},
 
-		{"valid empty", "", ""},
-		{"valid single", "e", ""},
-		{"valid limit", "5", ""},
-		{"valid with dots and 13 chars", "eosio.tokenfl", ""},
-		{"valid eos.Name", eos.Name("eosio"), ""},
-		{"valid eos.PermissionName", eos.PermissionName("eosio"), ""},
-		{"valid eos.ActionName", eos.ActionName("eosio"), ""},
-		{"valid eos.AccountName", eos.AccountName("eosio"), ""},
-		{"valid eos.TableName", eos.TableName("eosio"), ""},
+		{"valid asc", "asc", ""},
+		{"valid upper asc", "ASC", ""},
+		{"valid desc", "desc", ""},
+		{"valid mixed desc", "dEsC", ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 }
 
-func TestEOSExtendedNameRule(t *testing.T) {
-	tag := "eos_extended_name"
+func TestDateTimeRule(t *testing.T) {
+	tag := "date_time"
+	rule := DateTimeRuleFactory(time.RFC3339)
+	parsed := time.Date(2019, 1, 12, 15, 23, 34, 0, time.UTC)
 	validator := func(field string, value interface{}) error {
-		return EOSExtendedNameRule(field, tag, "", value)
+		return rule(field, tag, "", value)
 	}
 
 	tests := []ruleTestCase{
-		{"should be a string", true, "The test field is not a known type for an EOS name"},
-		{"should not contains invalid characters", "6", "The test field must be a valid EOS name"},
-		{"should not be longer than 13", "abcdefghigklma", "The test field must be a valid EOS name"},
-
-		{"valid empty", "", ""},
-		{"valid single", "e", ""},
-		{"valid limit", "5", ""},
-		{"valid with dots and 13 chars", "eosio.tokenfl", ""},
-		{"valid with whem symbol", "4,EOS", ""},
-		{"valid with whem symbol code", "EOS", ""},
+		{"should treat nil as empty", nil, "The test field is not a valid date time string according to layout 2006-01-02T15:04:05Z07:00"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should fail on valid layout", "2019-01-12 15:23:34", "The test field is not a valid date time string according to layout 2006-01-02T15:04:05Z07:00"},
 
-		{"valid eos.Name", eos.Name("eosio"), ""},
-		{"valid eos.PermissionName", eos.PermissionName("eosio"), ""},
-		{"valid eos.ActionName", eos.ActionName("eosio"), ""},
-		{"valid eos.AccountName", eos.AccountName("eosio"), ""},
-		{"valid eos.TableName", eos.TableName("eosio"), ""},
+		{"valid", "2019-01-12T15:23:34+00:00", ""},
+		{"valid time.Time", time.Date(2019, 1, 12, 15, 23, 34, 0, time.UTC), ""},
+		{"valid *time.Time", &parsed, ""},
+		{"valid nil *time.Time", (*time.Time)(nil), ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 }
 
-func TestEOSNamesListRule(t *testing.T) {
-	tag := "eos_names_list"
-	rule := EOSNamesListRuleFactory("|", 2)
+func TestDateTimeMultiLayoutRule(t *testing.T) {
+	tag := "date_time_multi"
+	rule := DateTimeMultiLayoutRuleFactory(time.RFC3339, "2006-01-02 15:04:05", "2006-01-02")
 	validator := func(field string, value interface{}) error {
 		return rule(field, tag, "", value)
 	}
 
 	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field is not a valid date time string according to layouts 2006-01-02T15:04:05Z07:00, 2006-01-02 15:04:05, 2006-01-02"},
 		{"should be a string", true, "The test field must be a string"},
-		{"should have at least 1 element", "", "The test field must have at least 1 element"},
-		{"should have at max macCount element", "eos|eos|eos", "The test field must have at most 2 elements"},
-		{"should fail on single error", "6", "The test[0] field must be a valid EOS name"},
-		{"should fail if any element error", "ab|6", "The test[1] field must be a valid EOS name"},
+		{"should fail on no matching layout", "12/01/2019", "The test field is not a valid date time string according to layouts 2006-01-02T15:04:05Z07:00, 2006-01-02 15:04:05, 2006-01-02"},
 
-		{"valid single", "ab", ""},
-		{"valid multiple", "ded|eos", ""},
+		{"valid first layout", "2019-01-12T15:23:34+00:00", ""},
+		{"valid second layout", "2019-01-12 15:23:34", ""},
+		{"valid third layout", "2019-01-12", ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 }
 
-func TestEOSExtendedNamesListRule(t *testing.T) {
-	tag := "eos_extended_names_list"
-	rule := EOSExtendedNamesListRuleFactory("|", 3)
+func TestTimeRangeRule(t *testing.T) {
+	tag := "time_range"
+	min := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	rule := TimeRangeRuleFactory(time.RFC3339, min, max)
 	validator := func(field string, value interface{}) error {
 		return rule(field, tag, "", value)
 	}
 
 	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field is not a valid date time string according to layout 2006-01-02T15:04:05Z07:00"},
 		{"should be a string", true, "The test field must be a string"},
-		{"should have at least 1 element", "", "The test field must have at least 1 element"},
-		{"should have at max macCount element", "eos|eos|eos|eos", "The test field must have at most 3 elements"},
-		{"should fail on single error", "6", "The test[0] field must be a valid EOS name"},
-		{"should fail if any element error", "ab|6", "The test[1] field must be a valid EOS name"},
+		{"should fail on valid layout", "2019-01-12 15:23:34", "The test field is not a valid date time string according to layout 2006-01-02T15:04:05Z07:00"},
+		{"should not be before min", "2018-05-31T23:59:59Z", "The test field must be between 2018-06-01T00:00:00Z and 2019-06-01T00:00:00Z"},
+		{"should not be after max", "2019-06-01T00:00:01Z", "The test field must be between 2018-06-01T00:00:00Z and 2019-06-01T00:00:00Z"},
+		{"should account for timezone", "2019-05-31T23:00:00-02:00", "The test field must be between 2018-06-01T00:00:00Z and 2019-06-01T00:00:00Z"},
+		{"should not be after max time.Time", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), "The test field must be between 2018-06-01T00:00:00Z and 2019-06-01T00:00:00Z"},
 
-		{"valid single", "ab", ""},
-		{"valid multiple", "ded|eos", ""},
-		{"valid multiple symbol", "ded|eos|EOS", ""},
-		{"valid multiple symbol code", "ded|4,EOS", ""},
-		{"valid multiple mixed", "ded|EOS|4,EOS", ""},
+		{"valid", "2019-01-12T15:23:34+00:00", ""},
+		{"valid min", "2018-06-01T00:00:00Z", ""},
+		{"valid max", "2019-06-01T00:00:00Z", ""},
+		{"valid time.Time", time.Date(2019, 1, 12, 15, 23, 34, 0, time.UTC), ""},
+		{"valid nil *time.Time", (*time.Time)(nil), ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 }
 
-func TestEOSTrxIDRule(t *testing.T) {
-	tag := "eos_trx_id"
+func TestDurationRule(t *testing.T) {
+	tag := "duration"
 	validator := func(field string, value interface{}) error {
-		return EOSTrxIDRule(field, tag, "", value)
+		return DurationRule(field, tag, "", value)
 	}
 
 	tests := []ruleTestCase{
-		{"should be a string", true, "The test field must be a string"},
-		{"should contains something", "", "The test field must be a valid hexadecimal"},
-		{"should contains a least two characters", "a", "The test field must be a valid hexadecimal"},
-		{"should not contains invalid characters", "az", "The test field must be a valid hexadecimal"},
-		{"should be a multple of 2", "ab01020", "The test field must be a valid hexadecimal"},
-		{"should be long enough", "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd1", "The test field must have exactly 64 characters"},
+		{"should treat nil as empty", nil, "The test field is not a valid duration"},
+		{"should be a known type", 30, "The test field is not a known type for a duration"},
+		{"should have a unit", "30", "The test field is not a valid duration"},
+		{"should have a known unit", "30d", "The test field is not a valid duration"},
 
-		{"valid", "d8fe02221408fbcc221d1207c1b8cc67e0d9b3ca1c6005a36ea10428dd7fd148", ""},
-		{"valid", "D8FE02221408FBCC221D1207C1B8CC67E0D9B3CA1C6005A36EA10428DD7FD148", ""},
+		{"valid seconds", "30s", ""},
+		{"valid compound", "1h30m", ""},
+		{"valid time.Duration", 5 * time.Minute, ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 }
 
-func TestCursorRule(t *testing.T) {
-	tag := "cursor"
+func TestDurationRangeRuleFactory(t *testing.T) {
+	tag := "duration_range"
+	rule := DurationRangeRuleFactory(time.Second, 24*time.Hour)
 	validator := func(field string, value interface{}) error {
-		return CursorRule(field, tag, "", value)
+		return rule(field, tag, "", value)
 	}
 
 	tests := []ruleTestCase{
-		{"happy path", "Wf_IQ72XbdmObmHnniHTKPazJ8IwBwxqBl3tfhdIh4z19XLF2p6hU2N9PUzZla_yjhLjTQis29jKHC9_ocZY7dDuyr9g73JpQS8pxYjp-eflePPybA==", ""},
-		{"empty cursor", "", ""},
-		{"invalid characters in cursor", "-----==", "The test field is not a valid cursor"},
-		{"invalid cursor", "abc", "The test field is not a valid cursor"},
+		{"should be a valid duration", "abc", "The test field is not a valid duration"},
+		{"should not be lower than min", "500ms", "The test field must be between 1s and 24h0m0s"},
+		{"should not be greater than max", 25 * time.Hour, "The test field must be between 1s and 24h0m0s"},
+
+		{"valid min", "1s", ""},
+		{"valid max", "24h", ""},
+		{"valid time.Duration", time.Minute, ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 }
 
-func TestDateTimeRule(t *testing.T) {
-	tag := "date_time"
-	rule := DateTimeRuleFactory(time.RFC3339)
+func TestUnixTimestampRule(t *testing.T) {
+	tag := "unix_timestamp"
+	rule := UnixTimestampRuleFactory(time.Second)
 	validator := func(field string, value interface{}) error {
 		return rule(field, tag, "", value)
 	}
 
 	tests := []ruleTestCase{
-		{"should be a string", true, "The test field must be a string"},
-		{"should fail on valid layout", "2019-01-12 15:23:34", "The test field is not a valid date time string according to layout 2006-01-02T15:04:05Z07:00"},
+		{"should treat nil as empty", nil, "The test field is not a valid unix timestamp"},
+		{"should be a known type", true, "The test field is not a known type for a unix timestamp"},
+		{"should be an integer", "1547306614.5", "The test field is not a valid unix timestamp"},
+		{"should not be empty", "", "The test field is not a valid unix timestamp"},
+		{"should not be negative", "-1", "The test field is not a valid unix timestamp"},
+		{"should not be negative int64", int64(-1), "The test field is not a valid unix timestamp"},
+		{"should not be absurdly large", "253402300800", "The test field is not a valid unix timestamp"},
+		{"should not be absurdly large json.Number", json.Number("1547306614000"), "The test field is not a valid unix timestamp"},
+		{"should not be a non-integral float64", 1547306614.5, "The test field is not a valid unix timestamp"},
 
-		{"valid", "2019-01-12T15:23:34+00:00", ""},
+		{"valid string", "1547306614", ""},
+		{"valid zero", "0", ""},
+		{"valid max", "253402300799", ""},
+		{"valid int64", int64(1547306614), ""},
+		{"valid int", 1547306614, ""},
+		{"valid json.Number", json.Number("1547306614"), ""},
+		{"valid uint32", uint32(1547306614), ""},
+		{"valid integral float64", float64(1547306614), ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
+
+	millisRule := UnixTimestampRuleFactory(time.Millisecond)
+	millisValidator := func(field string, value interface{}) error {
+		return millisRule(field, tag, "", value)
+	}
+
+	millisTests := []ruleTestCase{
+		{"should not be absurdly large", "253402300800000", "The test field is not a valid unix timestamp"},
+
+		{"valid", "1547306614000", ""},
+		{"valid max", "253402300799000", ""},
+	}
+
+	runRuleTestCases(t, tag+"_millis", millisTests, millisValidator)
 }
 
 func TestHexRowRule(t *testing.T) {
@@ -194,21 +2049,130 @@ func TestHexRowRule(t *testing.T) {
 		return HexRowRule(field, tag, "", value)
 	}
 
+	validHex := "ab"
+	invalidHex := "az"
+
 	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid hexadecimal"},
 		{"should be a string", true, "The test field must be a string"},
 		{"should contains something", "", "The test field must be a valid hexadecimal"},
-		{"should contains a least two characters", "a", "The test field must be a valid hexadecimal"},
+		{"should contains a least two characters", "a", "The test field must have an even number of characters"},
 		{"should not contains invalid characters", "az", "The test field must be a valid hexadecimal"},
-		{"should be a multple of 2", "ab01020", "The test field must be a valid hexadecimal"},
+		{"should report invalid characters before odd length", "abz", "The test field must be a valid hexadecimal"},
+		{"should be a multple of 2", "ab01020", "The test field must have an even number of characters"},
+
+		{"should not contains invalid characters *string", &invalidHex, "The test field must be a valid hexadecimal"},
 
 		{"valid", "ab", ""},
 		{"valid", "1234567890abcdefABCDEF", ""},
+		{"valid *string", &validHex, ""},
+		{"valid nil *string", (*string)(nil), ""},
+		{"valid bytes", []byte{0x01, 0xab}, ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 	runRuleTestCases(t, tag+"_deprecated", tests, deprecatedValidator)
 }
 
+func TestHexRule_LengthParam(t *testing.T) {
+	tag := "hex:64"
+	validator := func(field string, value interface{}) error {
+		return HexRule(field, tag, "", value)
+	}
+
+	valid := strings.Repeat("ab", 32)
+
+	tests := []ruleTestCase{
+		{"should still be hexadecimal", strings.Repeat("az", 32), "The test field must be a valid hexadecimal"},
+		{"should be exactly 64 characters, shorter", strings.Repeat("ab", 31), "The test field must have exactly 64 characters"},
+		{"should be exactly 64 characters, longer", strings.Repeat("ab", 33), "The test field must have exactly 64 characters"},
+		{"should be exactly 32 bytes", []byte{0x01, 0xab}, "The test field must have exactly 64 characters"},
+
+		{"valid", valid, ""},
+		{"valid *string", &valid, ""},
+		{"valid bytes", make([]byte, 32), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestHexRule_LengthParamOtherTag(t *testing.T) {
+	assert.NoError(t, HexRule("test", "hex_id:4", "", "abcd"))
+	assert.NoError(t, HexRule("test", "hex_id:4", "", "ab"))
+	assert.NoError(t, HexSliceRule("test", "hex_slice:3", "", []string{"ab"}))
+	assert.NoError(t, HexSliceRuleFactory(2)("test", "hex_slice:3", "", []string{"ab", "cdef"}))
+}
+
+func TestHexRule_InvalidLengthParam(t *testing.T) {
+	err := HexRule("test", "hex:abc", "", "ab")
+	assert.EqualError(t, err, "The test field has an invalid hex length parameter")
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "hex:abc", validationErr.Tag)
+}
+
+func TestHexRuleFactory(t *testing.T) {
+	tag := "hex_prefixed"
+	rule := HexRuleFactory(true)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid hexadecimal"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should contains something", "", "The test field must be a valid hexadecimal"},
+		{"should have a body after prefix", "0x", "The test field must be a valid hexadecimal"},
+		{"should not contains invalid characters", "0xaz", "The test field must be a valid hexadecimal"},
+		{"should be a multple of 2", "0xab0", "The test field must have an even number of characters"},
+		{"should not accept uppercase prefix", "0Xab", "The test field must be a valid hexadecimal"},
+
+		{"valid without prefix", "ab12", ""},
+		{"valid with prefix", "0xab12", ""},
+		{"valid bytes", []byte{0xab, 0x12}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	strictRule := HexRuleFactory(false)
+	strictValidator := func(field string, value interface{}) error {
+		return strictRule(field, tag, "", value)
+	}
+
+	strictTests := []ruleTestCase{
+		{"should not accept prefix", "0xab12", "The test field must be a valid hexadecimal"},
+
+		{"valid without prefix", "ab12", ""},
+	}
+
+	runRuleTestCases(t, tag+"_strict", strictTests, strictValidator)
+}
+
+func TestHexExactLengthRule(t *testing.T) {
+	tag := "hex_exact_length"
+	rule := HexExactLengthRuleFactory(4)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must be a valid hexadecimal"},
+		{"should be a string", true, "The test field must be a string"},
+		{"should contains something", "", "The test field must be a valid hexadecimal"},
+		{"should not contains invalid characters", "abcdefzz", "The test field must be a valid hexadecimal"},
+		{"should not be too short", "abcdef", "The test field must have exactly 8 characters"},
+		{"should not be too long", "abcdef0102", "The test field must have exactly 8 characters"},
+		{"should not be too short bytes", []byte{0xab}, "The test field must have exactly 8 characters"},
+
+		{"valid", "abcdef01", ""},
+		{"valid uppercase", "ABCDEF01", ""},
+		{"valid bytes", []byte{0xab, 0xcd, 0xef, 0x01}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
 func TestHexRowsRule(t *testing.T) {
 	tag := "hex_slice"
 	validator := func(field string, value interface{}) error {
@@ -220,19 +2184,216 @@ func TestHexRowsRule(t *testing.T) {
 	}
 
 	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
 		{"should be an array", "", "The test field must be a string array"},
 		{"should have at least 1 row", []string{}, "The test field must have at least 1 element"},
-		{"should fail on single error", []string{"a"}, "The test[0] field must be a valid hexadecimal"},
+		{"should have at least 1 bytes row", [][]byte{}, "The test field must have at least 1 element"},
+		{"should fail on single error", []string{"a"}, "The test[0] field must have an even number of characters"},
+		{"should fail on single invalid characters error", []string{"zz"}, "The test[0] field must be a valid hexadecimal"},
 		{"should fail if any row error", []string{"ab", "zz"}, "The test[1] field must be a valid hexadecimal"},
 
 		{"valid single row", []string{"ab"}, ""},
 		{"valid multiple rows", []string{"ab", "de"}, ""},
+		{"valid *[]string", &[]string{"ab"}, ""},
+		{"valid nil *[]string", (*[]string)(nil), ""},
+		{"valid bytes rows", [][]byte{{0x01}, {0xab}}, ""},
 	}
 
 	runRuleTestCases(t, tag, tests, validator)
 	runRuleTestCases(t, tag+"_deprecated", tests, deprecatedValidator)
 }
 
+func TestHexSliceRuleFactory(t *testing.T) {
+	tag := "hex_slice_max"
+	rule := HexSliceRuleFactory(2)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
+		{"should be an array", "", "The test field must be a string array"},
+		{"should have at least 1 row", []string{}, "The test field must have at least 1 element"},
+		{"should have at max maxCount rows", []string{"ab", "cd", "ef"}, "The test field must have at most 2 elements"},
+		{"should have at max maxCount bytes rows", [][]byte{{0x01}, {0x02}, {0x03}}, "The test field must have at most 2 elements"},
+		{"should fail if any row error", []string{"ab", "zz"}, "The test[1] field must be a valid hexadecimal"},
+
+		{"valid single row", []string{"ab"}, ""},
+		{"valid max rows", []string{"ab", "de"}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestHexSliceRuleFactory_Singular(t *testing.T) {
+	tag := "hex_slice_max"
+	rule := HexSliceRuleFactory(1)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should have at max 1 row", []string{"ab", "cd"}, "The test field must have at most 1 element"},
+
+		{"valid single row", []string{"ab"}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestHexSliceExactLengthRuleFactory(t *testing.T) {
+	tag := "hex_slice_exact_length"
+	rule := HexSliceExactLengthRuleFactory(2, 32)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	key := strings.Repeat("ab", 32)
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
+		{"should be an array", "", "The test field must be a string array"},
+		{"should have at max maxCount rows", []string{key, key, key}, "The test field must have at most 2 elements"},
+		{"should fail if any row error", []string{key, "zz"}, "The test[1] field must be a valid hexadecimal"},
+		{"should have exact row length", []string{key, "abcd"}, "The test[1] field must have exactly 64 characters"},
+		{"should have exact bytes row length", [][]byte{make([]byte, 32), make([]byte, 31)}, "The test[1] field must have exactly 64 characters"},
+
+		{"valid single row", []string{key}, ""},
+		{"valid max rows", []string{key, key}, ""},
+		{"valid bytes rows", [][]byte{make([]byte, 32)}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+}
+
+func TestHexSliceMaxBytesRuleFactory(t *testing.T) {
+	tag := "hex_slice_max_bytes"
+	rule := HexSliceMaxBytesRuleFactory(3, 4)
+	validator := func(field string, value interface{}) error {
+		return rule(field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should treat nil as empty", nil, "The test field must have at least 1 element"},
+		{"should have at max maxCount rows", []string{"ab", "cd", "ef", "01"}, "The test field must have at most 3 elements"},
+		{"should fail if any row error", []string{"ab", "zz"}, "The test[1] field must be a valid hexadecimal"},
+		{"should not exceed total bytes", []string{"abcd", "abcdef"}, "The test field exceeds 4 total bytes"},
+		{"should not exceed total bytes with bytes rows", [][]byte{make([]byte, 3), make([]byte, 2)}, "The test field exceeds 4 total bytes"},
+
+		{"valid under total bytes", []string{"ab", "cd"}, ""},
+		{"valid exact total bytes", []string{"abcd", "ef01"}, ""},
+		{"valid bytes rows", [][]byte{make([]byte, 4)}, ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+	assert.True(t, errors.Is(validator("test", []string{"abcdef", "abcd"}), ErrTooLong))
+}
+
+func TestWithoutContext(t *testing.T) {
+	rule := WithoutContext(EOSNameRule)
+
+	assert.NoError(t, rule(context.Background(), "test", "eos_name", "", "eosio"))
+	assert.EqualError(t, rule(context.Background(), "test", "eos_name", "", "6"), "The test field must be a valid EOS name")
+
+	factoryRule := WithoutContext(EOSNamesListRuleFactory("|", 1))
+	assert.EqualError(t, factoryRule(context.Background(), "test", "eos_names_list", "", "a|b"), "The test field must have at most 1 element")
+}
+
+type fakeAccountGetter map[eos.AccountName]error
+
+func (g fakeAccountGetter) GetAccount(ctx context.Context, name eos.AccountName) (*eos.AccountResp, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if err, found := g[name]; found {
+		return nil, err
+	}
+
+	return &eos.AccountResp{AccountName: name}, nil
+}
+
+func TestEOSAccountExistsRuleFactory(t *testing.T) {
+	accountQueryErr := eos.APIError{Code: 500, Message: "Internal Service Error"}
+	accountQueryErr.ErrorStruct.Code = 3060002
+
+	tag := "eos_account_exists"
+	rule := EOSAccountExistsRuleFactory(fakeAccountGetter{
+		"unknown":  eos.ErrNotFound,
+		"unknown2": accountQueryErr,
+	})
+	validator := func(field string, value interface{}) error {
+		return rule(context.Background(), field, tag, "", value)
+	}
+
+	tests := []ruleTestCase{
+		{"should be a string", true, "The test field is not a known type for an EOS name"},
+		{"should be a valid name", "6", "The test field must be a valid EOS name"},
+		{"should exist", "unknown", "The test field account does not exist"},
+		{"should exist account query", "unknown2", "The test field account does not exist"},
+
+		{"valid", "eosio", ""},
+		{"valid eos.AccountName", eos.AccountName("eosio"), ""},
+	}
+
+	runRuleTestCases(t, tag, tests, validator)
+
+	assert.True(t, errors.Is(validator("test", "unknown"), ErrEOSAccountNotFound))
+}
+
+func TestEOSAccountExistsRuleFactory_LookupError(t *testing.T) {
+	networkErr := errors.New("connection refused")
+	rule := EOSAccountExistsRuleFactory(fakeAccountGetter{"eosio": networkErr})
+
+	err := rule(context.Background(), "test", "eos_account_exists", "", "eosio")
+	require.Error(t, err)
+
+	var lookupErr *AccountLookupError
+	require.True(t, errors.As(err, &lookupErr))
+	assert.Equal(t, "eosio", lookupErr.Account)
+	assert.True(t, errors.Is(err, networkErr))
+	assert.False(t, errors.As(err, new(*ValidationError)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = EOSAccountExistsRuleFactory(fakeAccountGetter{})(ctx, "test", "eos_account_exists", "", "eosio")
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestDefaultRules(t *testing.T) {
+	for tag, rule := range DefaultRules {
+		assert.NotNil(t, rule, "rule for tag %q", tag)
+	}
+
+	tests := []struct {
+		tag           string
+		value         interface{}
+		expectedError string
+	}{
+		{"eos_name", "6", "The test field must be a valid EOS name"},
+		{"eos_name", "eosio", ""},
+		{"hex", "zz", "The test field must be a valid hexadecimal"},
+		{"hex", "ab", ""},
+		{"eos_block_num", "a", "The test field must be a valid EOS block num"},
+		{"eos_block_num", "10", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s_%v", test.tag, test.value), func(t *testing.T) {
+			rule, found := DefaultRules[test.tag]
+			require.True(t, found)
+
+			err := rule("test", test.tag, "", test.value)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
 func runRuleTestCases(t *testing.T, tag string, tests []ruleTestCase, validator func(field string, value interface{}) error) {
 	for _, test := range tests {
 		t.Run(fmt.Sprintf("%s_%s", tag, test.name), func(t *testing.T) {
@@ -241,8 +2402,53 @@ func runRuleTestCases(t *testing.T, tag string, tests []ruleTestCase, validator
 			if test.expectedError == "" {
 				assert.NoError(t, err)
 			} else {
-				assert.Equal(t, errors.New(test.expectedError), err)
+				assert.EqualError(t, err, test.expectedError)
 			}
 		})
 	}
 }
+
+func TestFactoryRulesConcurrentUse(t *testing.T) {
+	layouts := []string{time.RFC3339, "2006-01-02"}
+	allowed := []string{"asc", "desc"}
+
+	rules := map[string]RuleFunc{
+		"eos_names_list": EOSNamesListRuleFactory(",", 3, ListTrimSpace()),
+		"unique_names":   EOSNamesListRuleFactoryUnique("|", 3),
+		"date_time":      DateTimeMultiLayoutRuleFactory(layouts...),
+		"one_of":         OneOfFoldRuleFactory(allowed...),
+		"regex":          RegexRuleFactory("[a-z]+", "{field} is invalid"),
+		"allowlist":      EOSNameAllowlistRuleFactory("eosio"),
+		"and":            And(EOSNameRule, EOSNameSuffixRuleFactory("eosio")),
+	}
+
+	values := map[string][2]interface{}{
+		"eos_names_list": {"eosio, eosio.token", "eosio, 6"},
+		"unique_names":   {"eosio|eosio.token", "eosio|eosio"},
+		"date_time":      {"2020-01-02", "01/02/2020"},
+		"one_of":         {"ASC", "random"},
+		"regex":          {"abc", "ABC"},
+		"allowlist":      {"eosio", "eosio.token"},
+		"and":            {"token.eosio", "eosio"},
+	}
+
+	// Mutating the variadic arguments after the fact must not affect the rules
+	layouts[1] = time.Kitchen
+	allowed[0] = "random"
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for name, rule := range rules {
+					assert.NoError(t, rule("test", name, "", values[name][0]), name)
+					assert.Error(t, rule("test", name, "", values[name][1]), name)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,112 @@
+package validator
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// ValidateStructTags validates the exported fields of the struct `v` (or pointer to
+// it) according to their `validate` tag, a comma separated list of rule names of
+// `DefaultRules` (i.e. `validate:"eos_name"`). A rule name may be followed by a
+// `:` and a parameter (i.e. `hex:64`), the full rule is then given to the rule
+// as its `rule` argument. Fields are named after their `json` tag when present.
+//
+// The first failure of each field is aggregated in the returned `ValidationErrors`,
+// nil is returned when every field is valid.
+func ValidateStructTags(v interface{}) error {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("validator: expected a struct, got %T", v)
+	}
+
+	errs := ValidationErrors{}
+	structType := value.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		tag := structField.Tag.Get("validate")
+		if structField.PkgPath != "" || tag == "" || tag == "-" {
+			continue
+		}
+
+		field := fieldName(structField)
+		for _, rule := range strings.Split(tag, ",") {
+			rule = strings.TrimSpace(rule)
+			ruleFunc, found := DefaultRules[ruleName(rule)]
+			if !found {
+				return fmt.Errorf("validator: unknown rule %q on field %s", rule, structField.Name)
+			}
+
+			if err := ruleFunc(field, rule, "", value.Field(i).Interface()); err != nil {
+				errs[field] = err
+				break
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// ValidateValues validates each query parameter of `values` named in `rules` with
+// its rules, in order, a missing parameter being validated as an empty string.
+// The first failure of each parameter is aggregated in the returned
+// `ValidationErrors`, nil is returned when every parameter is valid.
+func ValidateValues(values url.Values, rules map[string][]RuleFunc) error {
+	errs := ValidationErrors{}
+	for field, fieldRules := range rules {
+		value := values.Get(field)
+		for _, ruleFunc := range fieldRules {
+			if err := ruleFunc(field, "", "", value); err != nil {
+				errs[field] = err
+				break
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// Validate validates `value` with the rule of `DefaultRules` named `tag`, which may
+// be followed by a `:` parameter like in `ValidateStructTags`. The field is named
+// `value` in the error message, see `ValidateField` to name it.
+func Validate(tag string, value interface{}) error {
+	return ValidateField(parseField, tag, value)
+}
+
+// ValidateField is like `Validate` but names the field `field` in the error message.
+func ValidateField(field string, tag string, value interface{}) error {
+	ruleFunc, found := DefaultRules[ruleName(tag)]
+	if !found {
+		return fmt.Errorf("validator: unknown rule %q", tag)
+	}
+
+	return ruleFunc(field, tag, "", value)
+}
+
+// ruleName returns the name of `rule`, stripped of its `:` parameter if any.
+func ruleName(rule string) string {
+	name, _, _ := cut(rule, ":")
+	return name
+}
+
+func fieldName(structField reflect.StructField) string {
+	name := strings.Split(structField.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return structField.Name
+	}
+
+	return name
+}
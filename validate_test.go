@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStructTags(t *testing.T) {
+	type transfer struct {
+		From     string  `json:"from" validate:"eos_name"`
+		To       string  `validate:"eos_name"`
+		Memo     string  `json:"memo,omitempty" validate:"eos_memo"`
+		Data     *string `json:"data" validate:"hex, eos_checksum256"`
+		Ignored  string  `json:"ignored"`
+		Skipped  string  `json:"skipped" validate:"-"`
+		internal string  `validate:"eos_name"`
+	}
+
+	invalidHex := "zz"
+	shortHex := "ab"
+	checksum := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name           string
+		value          interface{}
+		expectedErrors ValidationErrors
+	}{
+		{"valid", transfer{From: "eosio", To: "bob", Memo: "hi", Ignored: "6", Skipped: "6", internal: "6"}, nil},
+		{"valid pointer", &transfer{From: "eosio", To: "bob", Data: &checksum}, nil},
+		{
+			"stops at first rule failure",
+			transfer{From: "6", To: "bob", Data: &invalidHex},
+			ValidationErrors{
+				"from": errorFor(t, EOSNameRule("from", "eos_name", "", "6")),
+				"data": errorFor(t, HexRule("data", "hex", "", "zz")),
+			},
+		},
+		{
+			"applies every rule",
+			transfer{From: "eosio", To: "B", Data: &shortHex},
+			ValidationErrors{
+				"To":   errorFor(t, EOSNameRule("To", "eos_name", "", "B")),
+				"data": errorFor(t, EOSChecksum256Rule("data", "eos_checksum256", "", "ab")),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateStructTags(test.value)
+			if test.expectedErrors == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Equal(t, test.expectedErrors, err)
+		})
+	}
+}
+
+func TestValidateStructTags_Errors(t *testing.T) {
+	type unknownRule struct {
+		Field string `validate:"unknown"`
+	}
+
+	assert.EqualError(t, ValidateStructTags(unknownRule{}), `validator: unknown rule "unknown" on field Field`)
+	assert.EqualError(t, ValidateStructTags("eosio"), "validator: expected a struct, got string")
+}
+
+func TestValidateValues(t *testing.T) {
+	rules := map[string][]RuleFunc{
+		"account":   {EOSNameRule},
+		"block_num": {AllowEmpty(EOSBlockNumRule)},
+		"cursor":    {CursorRule},
+		"data":      {HexRule, HexExactLengthRuleFactory(2)},
+	}
+
+	tests := []struct {
+		name           string
+		values         url.Values
+		expectedErrors ValidationErrors
+	}{
+		{"valid", url.Values{"account": {"eosio"}, "block_num": {"10"}, "data": {"abcd"}}, nil},
+		{"valid ignores unknown", url.Values{"data": {"abcd"}, "other": {"6"}}, nil},
+		{
+			"invalid",
+			url.Values{"account": {"6"}, "block_num": {"a"}, "data": {"ab"}},
+			ValidationErrors{
+				"account":   errorFor(t, EOSNameRule("account", "", "", "6")),
+				"block_num": errorFor(t, EOSBlockNumRule("block_num", "", "", "a")),
+				"data":      errorFor(t, HexExactLengthRuleFactory(2)("data", "", "", "ab")),
+			},
+		},
+		{
+			"missing as empty",
+			url.Values{},
+			ValidationErrors{
+				"data": errorFor(t, HexRule("data", "", "", "")),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateValues(test.values, rules)
+			if test.expectedErrors == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Equal(t, test.expectedErrors, err)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("eos_name", "eosio"))
+	assert.EqualError(t, Validate("eos_name", "6"), "The value field must be a valid EOS name")
+	assert.EqualError(t, Validate("hex", "zz"), "The value field must be a valid hexadecimal")
+	assert.NoError(t, Validate("hex:4", "abcd"))
+	assert.EqualError(t, Validate("hex:4", "ab"), "The value field must have exactly 4 characters")
+	assert.EqualError(t, Validate("unknown", "eosio"), `validator: unknown rule "unknown"`)
+}
+
+func TestValidateField(t *testing.T) {
+	assert.NoError(t, ValidateField("account", "eos_name", "eosio"))
+	assert.EqualError(t, ValidateField("account", "eos_name", "6"), "The account field must be a valid EOS name")
+	assert.EqualError(t, ValidateField("account", "unknown", "eosio"), `validator: unknown rule "unknown"`)
+
+	err := ValidateField("account", "eos_name", "6")
+	assert.True(t, errors.Is(err, ErrInvalidEOSName))
+}
+
+func TestValidationErrors(t *testing.T) {
+	errs := ValidationErrors{
+		"to":   EOSNameRule("to", "eos_name", "", "6"),
+		"from": EOSNameRule("from", "eos_name", "", "6"),
+	}
+
+	assert.EqualError(t, errs, "The from field must be a valid EOS name; The to field must be a valid EOS name")
+	assert.True(t, errors.Is(errs, ErrInvalidEOSName))
+	assert.False(t, errors.Is(errs, ErrNotHex))
+}
+
+func errorFor(t *testing.T, err error) error {
+	require.Error(t, err)
+	return err
+}